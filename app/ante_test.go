@@ -0,0 +1,140 @@
+package app
+
+import (
+	"encoding/json"
+	cheqdtypes "github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const anteTestChainID = "cheqd-ante-test"
+
+// setupAnteTest returns an app after InitChain with the default genesis and identity fees set
+func setupAnteTest(t *testing.T) (*App, sdk.Context) {
+	encodingConfig := MakeEncodingConfig()
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encodingConfig, simapp.EmptyAppOptions{})
+
+	stateBytes, err := json.Marshal(NewDefaultGenesisState(encodingConfig.Codec))
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         anteTestChainID,
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: anteTestChainID, Height: 1})
+
+	params := app.cheqdKeeper.GetParams(ctx)
+	params.IdentityFees = []*cheqdtypes.IdentityFee{
+		{MsgTypeUrl: sdk.MsgTypeURL(&cheqdtypes.MsgCreateDid{}), Amount: []*sdk.Coin{{Denom: "ncheq", Amount: sdk.NewInt(50)}}},
+	}
+	params.BurnFactor = "0.5"
+	app.cheqdKeeper.SetParams(ctx, params)
+
+	return app, ctx
+}
+
+// anteHandler returns the ante handler wired as in New
+func anteHandler(t *testing.T, app *App) sdk.AnteHandler {
+	handler, err := NewAnteHandler(HandlerOptions{
+		AccountKeeper:   app.AccountKeeper,
+		BankKeeper:      app.BankKeeper,
+		FeegrantKeeper:  app.FeegrantKeeper,
+		CheqdKeeper:     app.cheqdKeeper,
+		SignModeHandler: MakeEncodingConfig().TxConfig.SignModeHandler(),
+	})
+	require.NoError(t, err)
+
+	return handler
+}
+
+// identityFeeTx returns a signed tx with a DID creation paid by a funded account
+func identityFeeTx(t *testing.T, app *App, ctx sdk.Context, fee sdk.Coins, gas uint64) sdk.Tx {
+	key := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(key.PubKey().Address())
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 1000000))))
+
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 1))),
+		&cheqdtypes.MsgCreateDid{
+			Payload:    &cheqdtypes.MsgCreateDidPayload{Id: "did:cheqd:test:alice"},
+			Signatures: []*cheqdtypes.SignInfo{{VerificationMethodId: "did:cheqd:test:alice#key-1", Signature: "signature"}},
+		},
+	}
+
+	account := app.AccountKeeper.GetAccount(ctx, addr)
+	tx, err := helpers.GenTx(MakeEncodingConfig().TxConfig, msgs, fee, gas, anteTestChainID, []uint64{account.GetAccountNumber()}, []uint64{account.GetSequence()}, key)
+	require.NoError(t, err)
+
+	return tx
+}
+
+func TestAnteHandler_IdentityFee(t *testing.T) {
+	app, ctx := setupAnteTest(t)
+
+	// The state of a rejected tx is discarded, as baseapp does
+	rejectedCtx, _ := ctx.CacheContext()
+	tx := identityFeeTx(t, app, rejectedCtx, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 49)), 200000)
+	_, err := anteHandler(t, app)(rejectedCtx, tx, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "insufficient fee for identity messages; got: 49ncheq required: 50ncheq")
+
+	tx = identityFeeTx(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 60)), 200000)
+	supply := app.BankKeeper.GetSupply(ctx, "ncheq")
+	_, err = anteHandler(t, app)(ctx, tx, false)
+	require.NoError(t, err)
+
+	// The burn factor share of the identity fee is burnt, the rest of the fee stays with the fee collector
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, sdk.NewInt64Coin("ncheq", 35), app.BankKeeper.GetBalance(ctx, feeCollector, "ncheq"))
+	require.Equal(t, supply.Amount.SubRaw(25), app.BankKeeper.GetSupply(ctx, "ncheq").Amount)
+}
+
+func TestAnteHandler_IdentityFeeMinGasPrices(t *testing.T) {
+	app, ctx := setupAnteTest(t)
+	ctx = ctx.
+		WithIsCheckTx(true).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("ncheq", sdk.NewDecWithPrec(1, 3))))
+
+	// 200000 gas at 0.001ncheq requires 200ncheq, which the mempool check alone would accept
+	tx := identityFeeTx(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 200)), 200000)
+	_, err := anteHandler(t, app)(ctx, tx, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "insufficient fee for gas after identity fees 50ncheq; got: 150ncheq required: 200ncheq")
+
+	tx = identityFeeTx(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 250)), 200000)
+	_, err = anteHandler(t, app)(ctx, tx, false)
+	require.NoError(t, err)
+}
+
+func TestAnteHandler_IdentityFeeSimulation(t *testing.T) {
+	app, ctx := setupAnteTest(t)
+
+	tx := identityFeeTx(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 50)), 200000)
+	supply := app.BankKeeper.GetSupply(ctx, "ncheq")
+
+	// The simulation runs the burn, so the estimated gas covers it
+	simulated, err := anteHandler(t, app)(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), tx, true)
+	require.NoError(t, err)
+	require.Equal(t, supply.Amount.SubRaw(25), app.BankKeeper.GetSupply(ctx, "ncheq").Amount)
+
+	app, ctx = setupAnteTest(t)
+	tx = identityFeeTx(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 50)), 200000)
+
+	delivered, err := anteHandler(t, app)(ctx, tx, false)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, simulated.GasMeter().GasConsumed(), delivered.GasMeter().GasConsumed())
+}
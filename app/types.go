@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // App implements the common methods for a Cosmos SDK-based application
@@ -37,4 +38,7 @@ type CosmosApp interface {
 
 	// All the registered module account addreses.
 	ModuleAccountAddrs() map[string]bool
+
+	// Helper for the simulation framework.
+	SimulationManager() *module.SimulationManager
 }
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"net/http"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/client/rest"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagResolverLaddr     = "laddr"
+	FlagResolverCacheSize = "cache-size"
+)
+
+// resolverCmd returns resolver cobra Command.
+func resolverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolver",
+		Short: "DID resolver for the DIF Universal Resolver",
+	}
+
+	cmd.AddCommand(resolverServeCmd())
+
+	return cmd
+}
+
+// resolverServeCmd returns resolver serve cobra Command.
+func resolverServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the Universal Resolver driver API",
+		Long: "Serve GET /1.0/identifiers/{did} backed by the node queries. The representation is chosen by the " +
+			"Accept header: application/did+ld+json, application/did+json or the full DID Resolution Result.",
+		Example: "serve --laddr 0.0.0.0:8080 --node tcp://localhost:26657",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			laddr, err := cmd.Flags().GetString(FlagResolverLaddr)
+			if err != nil {
+				return err
+			}
+
+			cacheSize, err := cmd.Flags().GetInt(FlagResolverCacheSize)
+			if err != nil {
+				return err
+			}
+
+			router := mux.NewRouter()
+			rest.NewResolverDriver(rest.NewClientResolverBackend(clientCtx), cacheSize).RegisterRoutes(router)
+
+			cmd.Printf("Resolver is listening on %s\n", laddr)
+			return http.ListenAndServe(laddr, router)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagResolverLaddr, "0.0.0.0:8080", "The address the resolver listens on")
+	cmd.Flags().Int(FlagResolverCacheSize, 10000, "The maximal number of DIDs cached per block height")
+
+	return cmd
+}
@@ -0,0 +1,130 @@
+package ante
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// CheqdKeeper is the part of the cheqd keeper the identity fee decorator uses
+type CheqdKeeper interface {
+	GetParams(ctx sdk.Context) v1.Params
+}
+
+// BankKeeper is the part of the bank keeper the identity fee decorator uses
+type BankKeeper interface {
+	authtypes.BankKeeper
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// IdentityFeeDecorator charges the fixed fees of identity messages set in the cheqd module params.
+// The tx fee should cover the sum of the identity fees of its messages, including the ones executed through authz.
+// On CheckTx the rest of the fee should also cover the node minimum gas prices, so the same coins don't pay both.
+// The fee is collected by DeductFeeDecorator, so IdentityFeeDecorator should follow it.
+// The burn factor share of the identity fees is burnt from the fee collector.
+// Simulations run the burn as well, so the gas estimate includes it. Like DeductFeeDecorator,
+// they skip it when the simulated fee doesn't cover the identity fees.
+type IdentityFeeDecorator struct {
+	cheqdKeeper CheqdKeeper
+	bankKeeper  BankKeeper
+}
+
+func NewIdentityFeeDecorator(ck CheqdKeeper, bk BankKeeper) IdentityFeeDecorator {
+	return IdentityFeeDecorator{
+		cheqdKeeper: ck,
+		bankKeeper:  bk,
+	}
+}
+
+func (ifd IdentityFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params := ifd.cheqdKeeper.GetParams(ctx)
+
+	identityFee, err := GetIdentityFee(params, feeTx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+
+	if identityFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	fee := feeTx.GetFee()
+	if !fee.IsAllGTE(identityFee) {
+		if simulate {
+			return next(ctx, tx, simulate)
+		}
+
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fee for identity messages; got: %s required: %s", fee, identityFee)
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		gasFee := fee.Sub(identityFee)
+		if minGasFee := GetMinGasFee(ctx, feeTx.GetGas()); !minGasFee.IsZero() && !gasFee.IsAnyGTE(minGasFee) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fee for gas after identity fees %s; got: %s required: %s", identityFee, gasFee, minGasFee)
+		}
+	}
+
+	burn, _ := sdk.NewDecCoinsFromCoins(identityFee...).MulDecTruncate(params.GetBurnFactorDec()).TruncateDecimal()
+	if !burn.IsZero() {
+		if err := ifd.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, v1.ModuleName, burn); err != nil {
+			return ctx, err
+		}
+
+		if err := ifd.bankKeeper.BurnCoins(ctx, v1.ModuleName, burn); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// GetMinGasFee returns the fee the node minimum gas prices require for the gas, as MempoolFeeDecorator computes it
+func GetMinGasFee(ctx sdk.Context, gas uint64) sdk.Coins {
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return sdk.Coins{}
+	}
+
+	fee := make(sdk.Coins, len(minGasPrices))
+	gasDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(gasDec).Ceil().RoundInt())
+	}
+
+	return fee
+}
+
+// GetIdentityFee returns the sum of the identity fees of the messages
+func GetIdentityFee(params v1.Params, msgs []sdk.Msg) (sdk.Coins, error) {
+	fee := sdk.NewCoins()
+
+	for _, msg := range msgs {
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+
+			execFee, err := GetIdentityFee(params, execMsgs)
+			if err != nil {
+				return nil, err
+			}
+
+			fee = fee.Add(execFee...)
+			continue
+		}
+
+		fee = fee.Add(params.GetIdentityFee(sdk.MsgTypeURL(msg))...)
+	}
+
+	return fee, nil
+}
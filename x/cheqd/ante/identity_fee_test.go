@@ -0,0 +1,158 @@
+package ante
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+type feeTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+	gas  uint64
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx feeTx) ValidateBasic() error       { return nil }
+func (tx feeTx) GetGas() uint64             { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return nil }
+
+type cheqdKeeper struct {
+	params v1.Params
+}
+
+func (ck cheqdKeeper) GetParams(sdk.Context) v1.Params {
+	return ck.params
+}
+
+// bankKeeper records the coins moved by IdentityFeeDecorator
+type bankKeeper struct {
+	sent   sdk.Coins
+	burned sdk.Coins
+}
+
+func (bk *bankKeeper) SendCoinsFromAccountToModule(sdk.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return nil
+}
+
+func (bk *bankKeeper) SendCoinsFromModuleToModule(_ sdk.Context, sender, recipient string, amt sdk.Coins) error {
+	if sender != authtypes.FeeCollectorName || recipient != v1.ModuleName {
+		panic("unexpected modules")
+	}
+
+	bk.sent = bk.sent.Add(amt...)
+	return nil
+}
+
+func (bk *bankKeeper) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	if moduleName != v1.ModuleName {
+		panic("unexpected module")
+	}
+
+	bk.burned = bk.burned.Add(amt...)
+	return nil
+}
+
+func identityFeeParams() v1.Params {
+	params := v1.DefaultParams()
+	params.IdentityFees = []*v1.IdentityFee{
+		{MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgCreateDid{}), Amount: []*sdk.Coin{{Denom: "ncheq", Amount: sdk.NewInt(50)}}},
+		{MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgUpdateDid{}), Amount: []*sdk.Coin{{Denom: "ncheq", Amount: sdk.NewInt(25)}}},
+	}
+	params.BurnFactor = "0.5"
+
+	return params
+}
+
+// anteHandle runs IdentityFeeDecorator and reports whether the next decorator was called
+func anteHandle(ctx sdk.Context, params v1.Params, bk *bankKeeper, tx sdk.Tx, simulate bool) (bool, error) {
+	decorator := NewIdentityFeeDecorator(cheqdKeeper{params: params}, bk)
+
+	var called bool
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		called = true
+		return ctx, nil
+	}
+
+	_, err := decorator.AnteHandle(ctx, tx, simulate, next)
+	return called, err
+}
+
+func TestIdentityFee_NotChargedByDefault(t *testing.T) {
+	bk := &bankKeeper{}
+
+	called, err := anteHandle(sdk.Context{}, v1.DefaultParams(), bk, feeTx{msgs: []sdk.Msg{&v1.MsgCreateDid{}}}, false)
+	require.Nil(t, err)
+	require.True(t, called)
+	require.True(t, bk.burned.IsZero())
+}
+
+func TestIdentityFee_Charged(t *testing.T) {
+	bk := &bankKeeper{}
+	msgs := []sdk.Msg{&v1.MsgCreateDid{}, &v1.MsgUpdateDid{}, &banktypes.MsgSend{}}
+
+	called, err := anteHandle(sdk.Context{}, identityFeeParams(), bk, feeTx{msgs: msgs, fee: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 74))}, false)
+	require.EqualError(t, err, "insufficient fee for identity messages; got: 74ncheq required: 75ncheq: insufficient fee")
+	require.False(t, called)
+
+	called, err = anteHandle(sdk.Context{}, identityFeeParams(), bk, feeTx{msgs: msgs, fee: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 100))}, false)
+	require.Nil(t, err)
+	require.True(t, called)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 37)), bk.sent)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 37)), bk.burned)
+}
+
+func TestIdentityFee_MinGasFee(t *testing.T) {
+	ctx := sdk.Context{}.
+		WithIsCheckTx(true).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("ncheq", sdk.NewDecWithPrec(5, 1))))
+	msgs := []sdk.Msg{&v1.MsgCreateDid{}}
+
+	// 100 gas at 0.5ncheq requires 50ncheq on top of the 50ncheq identity fee
+	_, err := anteHandle(ctx, identityFeeParams(), &bankKeeper{}, feeTx{msgs: msgs, fee: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 99)), gas: 100}, false)
+	require.EqualError(t, err, "insufficient fee for gas after identity fees 50ncheq; got: 49ncheq required: 50ncheq: insufficient fee")
+
+	called, err := anteHandle(ctx, identityFeeParams(), &bankKeeper{}, feeTx{msgs: msgs, fee: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 100)), gas: 100}, false)
+	require.Nil(t, err)
+	require.True(t, called)
+
+	// Minimum gas prices are local to the node, so DeliverTx doesn't check them
+	called, err = anteHandle(ctx.WithIsCheckTx(false), identityFeeParams(), &bankKeeper{}, feeTx{msgs: msgs, fee: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 50)), gas: 100}, false)
+	require.Nil(t, err)
+	require.True(t, called)
+}
+
+func TestIdentityFee_Simulation(t *testing.T) {
+	msgs := []sdk.Msg{&v1.MsgCreateDid{}}
+
+	// The burn runs on simulation, so its gas is part of the estimate
+	bk := &bankKeeper{}
+	called, err := anteHandle(sdk.Context{}, identityFeeParams(), bk, feeTx{msgs: msgs, fee: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 50))}, true)
+	require.Nil(t, err)
+	require.True(t, called)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 25)), bk.burned)
+
+	// Simulations without the fee aren't rejected
+	bk = &bankKeeper{}
+	called, err = anteHandle(sdk.Context{}, identityFeeParams(), bk, feeTx{msgs: msgs}, true)
+	require.Nil(t, err)
+	require.True(t, called)
+	require.True(t, bk.burned.IsZero())
+}
+
+func TestIdentityFee_AuthzExec(t *testing.T) {
+	params := identityFeeParams()
+
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{&v1.MsgCreateDid{}, &v1.MsgUpdateDid{}})
+
+	fee, err := GetIdentityFee(params, []sdk.Msg{&exec, &v1.MsgUpdateDid{}})
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ncheq", 100)), fee)
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+const (
+	PayloadTypeCreateDid = "create-did"
+	PayloadTypeUpdateDid = "update-did"
+)
+
+// IdentityPayload is a payload of an identity message which can be read from JSON and signed
+type IdentityPayload interface {
+	v1.IdentityMsg
+	proto.Message
+}
+
+// GetIdentityCmd returns the commands managing DID keys and signing identity payloads locally
+func GetIdentityCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "identity",
+		Short:                      "Manage DID keys and sign DID payloads",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdIdentityKeys(),
+		CmdSignPayload(),
+		CmdIdentityPayload(),
+	)
+
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
+
+	return cmd
+}
+
+func CmdSignPayload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-payload [create-did|update-did] [payload-json]",
+		Short: "Sign a DID payload",
+		Long: "Sign MsgCreateDidPayload or MsgUpdateDidPayload passed as JSON or a path to a JSON file. " +
+			"The payload is signed with the keys of the verification methods passed by --" + FlagSignKeyFile + " or --" + FlagSignKeyring + ". " +
+			"The signatures are printed as a JSON list of SignInfo.",
+		Example: "sign-payload create-did payload.json --sign-keyring did:cheqd:testnet:alice#key-1=alice-key-1",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payload, err := NewPayload(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := ReadPayload(clientCtx, args[1], payload); err != nil {
+				return err
+			}
+
+			signatures, err := SignPayload(cmd, clientCtx, payload)
+			if err != nil {
+				return err
+			}
+
+			json, err := MarshalSignInfos(clientCtx, signatures)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(string(json) + "\n")
+		},
+	}
+
+	AddSignFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPayload returns an empty payload of the given type
+func NewPayload(payloadType string) (IdentityPayload, error) {
+	switch payloadType {
+	case PayloadTypeCreateDid:
+		return &v1.MsgCreateDidPayload{}, nil
+	case PayloadTypeUpdateDid:
+		return &v1.MsgUpdateDidPayload{}, nil
+	default:
+		return nil, fmt.Errorf("unknown payload type %s, expected %s or %s", payloadType, PayloadTypeCreateDid, PayloadTypeUpdateDid)
+	}
+}
+
+// MarshalSignInfos marshals signatures into a JSON list
+func MarshalSignInfos(clientCtx client.Context, signatures []*v1.SignInfo) ([]byte, error) {
+	items := make([]string, 0, len(signatures))
+
+	for _, signature := range signatures {
+		json, err := clientCtx.Codec.MarshalJSON(signature)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, string(json))
+	}
+
+	return []byte("[" + strings.Join(items, ",") + "]"), nil
+}
@@ -0,0 +1,338 @@
+package cli
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmosed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/multiformats/go-multibase"
+	"github.com/spf13/cobra"
+)
+
+// IdentityKeyOutput describes a DID key in the keyring by the representations used in verification methods
+type IdentityKeyOutput struct {
+	Name               string       `json:"name" yaml:"name"`
+	PublicKeyMultibase string       `json:"publicKeyMultibase" yaml:"publicKeyMultibase"`
+	PublicKeyJwk       PublicKeyJwk `json:"publicKeyJwk" yaml:"publicKeyJwk"`
+}
+
+// PublicKeyJwk is an ed25519 public key as JWK (RFC 8037)
+type PublicKeyJwk struct {
+	Kty string `json:"kty" yaml:"kty"`
+	Crv string `json:"crv" yaml:"crv"`
+	X   string `json:"x" yaml:"x"`
+}
+
+func CmdIdentityKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "keys",
+		Short:                      "Manage ed25519 DID keys in the keyring",
+		Long:                       "Manage ed25519 keys of DID verification methods. The keys are stored in the same keyring backends as account keys.",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdIdentityKeysAdd(),
+		CmdIdentityKeysImport(),
+		CmdIdentityKeysList(),
+		CmdIdentityKeysShow(),
+		CmdIdentityKeysExport(),
+	)
+
+	return cmd
+}
+
+func CmdIdentityKeysAdd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add [name]",
+		Short:   "Generate a new ed25519 DID key",
+		Example: "add alice-key-1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, privKey, err := ed25519.GenerateKey(rand.Reader)
+			if err != nil {
+				return err
+			}
+
+			info, err := AddIdentityKey(clientCtx.Keyring, args[0], privKey)
+			if err != nil {
+				return err
+			}
+
+			return printIdentityKey(clientCtx, info)
+		},
+	}
+
+	return cmd
+}
+
+func CmdIdentityKeysImport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [name] [key-file]",
+		Short: "Import an ed25519 DID key",
+		Long: "Import a base64 encoded ed25519 private key or its seed from the key file. " +
+			"Without the key file the key is read from stdin, so it doesn't leak to the shell history and the process list.",
+		Example: "import alice-key-1 alice-key-1.txt",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			privKey, err := ReadImportedIdentityKey(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			info, err := AddIdentityKey(clientCtx.Keyring, args[0], privKey)
+			if err != nil {
+				return err
+			}
+
+			return printIdentityKey(clientCtx, info)
+		},
+	}
+
+	return cmd
+}
+
+func CmdIdentityKeysList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List ed25519 DID keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keys, err := ListIdentityKeys(clientCtx.Keyring)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(keys)
+		},
+	}
+
+	return cmd
+}
+
+func CmdIdentityKeysShow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [name]",
+		Short: "Show the public key of an ed25519 DID key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			info, err := GetIdentityKey(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			return printIdentityKey(clientCtx, info)
+		},
+	}
+
+	return cmd
+}
+
+func CmdIdentityKeysExport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [name]",
+		Short: "Export an ed25519 DID key",
+		Long: "Export the base64 encoded ed25519 private key. It can be saved to a file for --" + FlagSignKeyFile + " or imported into another keyring. " +
+			"The key is printed unencrypted, so the command asks for a confirmation unless --" + flags.FlagSkipConfirmation + " is passed.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			skipConfirmation, err := cmd.Flags().GetBool(flags.FlagSkipConfirmation)
+			if err != nil {
+				return err
+			}
+
+			if !skipConfirmation {
+				buf := bufio.NewReader(cmd.InOrStdin())
+				ok, err := input.GetConfirmation("WARNING: the private key will be exported as an unencrypted base64 string. Continue?", buf, cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+
+				if !ok {
+					return nil
+				}
+			}
+
+			privKey, err := ExportIdentityKey(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(base64.StdEncoding.EncodeToString(privKey) + "\n")
+		},
+	}
+
+	cmd.Flags().BoolP(flags.FlagSkipConfirmation, "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// AddIdentityKey stores an ed25519 private key in the keyring.
+// The keyring can't generate ed25519 keys, so the key is passed as an armored private key.
+func AddIdentityKey(kr keyring.Keyring, name string, privKey ed25519.PrivateKey) (keyring.Info, error) {
+	if _, err := kr.Key(name); err == nil {
+		return nil, fmt.Errorf("key %s already exists", name)
+	}
+
+	passphrase, err := newArmorPassphrase()
+	if err != nil {
+		return nil, err
+	}
+
+	armor := crypto.EncryptArmorPrivKey(&cosmosed25519.PrivKey{Key: privKey}, passphrase, string(hd.Ed25519Type))
+
+	if err := kr.ImportPrivKey(name, armor, passphrase); err != nil {
+		return nil, err
+	}
+
+	return kr.Key(name)
+}
+
+// ReadImportedIdentityKey reads the private key of `keys import` from the key file or, if it isn't passed, from stdin
+func ReadImportedIdentityKey(cmd *cobra.Command, args []string) (ed25519.PrivateKey, error) {
+	if len(args) > 1 {
+		return ReadPrivateKeyFile(args[1])
+	}
+
+	buf := bufio.NewReader(cmd.InOrStdin())
+	encoded, err := input.GetPassword("Enter the base64 encoded private key:", buf)
+
+	// Keys of a wrong length are reported by ParsePrivateKey
+	if err != nil && len(encoded) == 0 {
+		return nil, err
+	}
+
+	return ParsePrivateKey(strings.TrimSpace(encoded))
+}
+
+// GetIdentityKey returns the keyring info of an ed25519 key
+func GetIdentityKey(kr keyring.Keyring, name string) (keyring.Info, error) {
+	info, err := kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.GetAlgo() != hd.Ed25519Type {
+		return nil, fmt.Errorf("key %s should be ed25519, got %s", name, info.GetAlgo())
+	}
+
+	return info, nil
+}
+
+// ListIdentityKeys returns ed25519 keys of the keyring
+func ListIdentityKeys(kr keyring.Keyring) ([]IdentityKeyOutput, error) {
+	infos, err := kr.List()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []IdentityKeyOutput{}
+	for _, info := range infos {
+		if info.GetAlgo() != hd.Ed25519Type {
+			continue
+		}
+
+		keys = append(keys, NewIdentityKeyOutput(info))
+	}
+
+	return keys, nil
+}
+
+// ExportIdentityKey returns the ed25519 private key stored in the keyring
+func ExportIdentityKey(kr keyring.Keyring, name string) (ed25519.PrivateKey, error) {
+	if _, err := GetIdentityKey(kr, name); err != nil {
+		return nil, err
+	}
+
+	passphrase, err := newArmorPassphrase()
+	if err != nil {
+		return nil, err
+	}
+
+	armor, err := kr.ExportPrivKeyArmor(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	ed25519PrivKey, ok := privKey.(*cosmosed25519.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("key %s should be ed25519, got %s", name, privKey.Type())
+	}
+
+	return ed25519PrivKey.Key, nil
+}
+
+// NewIdentityKeyOutput returns the public key representations of an ed25519 keyring key
+func NewIdentityKeyOutput(info keyring.Info) IdentityKeyOutput {
+	pubKey := info.GetPubKey().Bytes()
+
+	// Base58 btc multibase encoding can't fail
+	publicKeyMultibase, _ := multibase.Encode(multibase.Base58BTC, pubKey)
+
+	return IdentityKeyOutput{
+		Name:               info.GetName(),
+		PublicKeyMultibase: publicKeyMultibase,
+		PublicKeyJwk: PublicKeyJwk{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(pubKey),
+		},
+	}
+}
+
+func printIdentityKey(clientCtx client.Context, info keyring.Info) error {
+	return clientCtx.PrintObjectLegacy(NewIdentityKeyOutput(info))
+}
+
+// newArmorPassphrase returns a random passphrase.
+// It only protects the armored key while it's passed to or from the keyring.
+func newArmorPassphrase() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(bytes), nil
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/cheqd/cheqd-node/app/params"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityKeys(t *testing.T) {
+	kr := keyring.NewInMemory()
+	pubKey, privKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	info, err := AddIdentityKey(kr, "alice-key-1", privKey)
+	require.Nil(t, err)
+	require.Equal(t, hd.Ed25519Type, info.GetAlgo())
+
+	_, err = AddIdentityKey(kr, "alice-key-1", privKey)
+	require.EqualError(t, err, "key alice-key-1 already exists")
+
+	output := NewIdentityKeyOutput(info)
+	require.Equal(t, "z"+base58.Encode(pubKey), output.PublicKeyMultibase)
+	require.Equal(t, base64.RawURLEncoding.EncodeToString(pubKey), output.PublicKeyJwk.X)
+
+	exported, err := ExportIdentityKey(kr, "alice-key-1")
+	require.Nil(t, err)
+	require.Equal(t, privKey, exported)
+
+	// Account keys are not listed
+	_, _, err = kr.NewMnemonic("account", keyring.English, "", "", hd.Secp256k1)
+	require.Nil(t, err)
+
+	keys, err := ListIdentityKeys(kr)
+	require.Nil(t, err)
+	require.Equal(t, []IdentityKeyOutput{output}, keys)
+
+	_, err = GetIdentityKey(kr, "account")
+	require.EqualError(t, err, "key account should be ed25519, got secp256k1")
+}
+
+func TestReadImportedIdentityKey(t *testing.T) {
+	_, privKey, _ := ed25519.GenerateKey(rand.Reader)
+	encoded := base64.StdEncoding.EncodeToString(privKey)
+
+	// From stdin
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader(encoded + "\n"))
+
+	imported, err := ReadImportedIdentityKey(cmd, []string{"alice-key-1"})
+	require.Nil(t, err)
+	require.Equal(t, privKey, imported)
+
+	// From the key file
+	keyFile := filepath.Join(t.TempDir(), "key.txt")
+	require.Nil(t, ioutil.WriteFile(keyFile, []byte(encoded+"\n"), 0600))
+
+	imported, err = ReadImportedIdentityKey(&cobra.Command{}, []string{"alice-key-1", keyFile})
+	require.Nil(t, err)
+	require.Equal(t, privKey, imported)
+
+	cmd.SetIn(strings.NewReader("AAAA\n"))
+	_, err = ReadImportedIdentityKey(cmd, []string{"alice-key-1"})
+	require.EqualError(t, err, "ed25519 private key should be 64 or 32 bytes, got 3")
+}
+
+func TestSignPayloadWithKeyring(t *testing.T) {
+	encodingConfig := params.MakeEncodingConfig()
+	kr := keyring.NewInMemory()
+	clientCtx := client.Context{}.WithCodec(encodingConfig.Codec).WithKeyring(kr)
+
+	pubKey, privKey, _ := ed25519.GenerateKey(rand.Reader)
+	_, err := AddIdentityKey(kr, "alice-key-1", privKey)
+	require.Nil(t, err)
+
+	payload, err := NewPayload(PayloadTypeUpdateDid)
+	require.Nil(t, err)
+	require.Nil(t, ReadPayload(clientCtx, `{"id": "did:cheqd:test:alice", "version_id": "1"}`, payload))
+
+	cmd := &cobra.Command{}
+	AddSignFlagsToCmd(cmd)
+	require.Nil(t, cmd.Flags().Set(FlagSignKeyring, "did:cheqd:test:alice#key-1=alice-key-1"))
+
+	signatures, err := SignPayload(cmd, clientCtx, payload)
+	require.Nil(t, err)
+
+	signature, _ := base64.StdEncoding.DecodeString(signatures[0].Signature)
+	require.True(t, ed25519.Verify(pubKey, payload.GetSignBytes(), signature))
+
+	output, err := MarshalSignInfos(clientCtx, signatures)
+	require.Nil(t, err)
+
+	var parsed []v1.SignInfo
+	require.Nil(t, json.NewDecoder(bytes.NewReader(output)).Decode(&parsed))
+	require.Equal(t, *signatures[0], parsed[0])
+
+	_, err = NewPayload("deactivate-did")
+	require.Error(t, err)
+}
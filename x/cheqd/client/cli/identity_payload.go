@@ -0,0 +1,417 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SignerStatusSigned  = "signed"
+	SignerStatusMissing = "missing"
+	SignerStatusInvalid = "invalid"
+)
+
+// SignerStatus tells whether the signatures of a required signer are present and valid
+type SignerStatus struct {
+	Did    string `json:"did" yaml:"did"`
+	Status string `json:"status" yaml:"status"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// SigningStatus lists the signers required by an identity message
+type SigningStatus struct {
+	Complete bool           `json:"complete" yaml:"complete"`
+	Signers  []SignerStatus `json:"signers" yaml:"signers"`
+}
+
+// CmdIdentityPayload returns the commands for signing a payload by several parties.
+// The parties pass around a signing file: the identity message as JSON with the signatures collected so far.
+func CmdIdentityPayload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payload",
+		Short: "Sign DID payloads offline by several parties",
+		Long: "Sign a DID payload by several parties. The payload is exported to a signing file, " +
+			"each party appends its signatures offline, the signing files are merged and the result is sent by `tx cheqd send-signed-payload`.",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdIdentityPayloadExport(),
+		CmdIdentityPayloadSign(),
+		CmdIdentityPayloadMerge(),
+		CmdIdentityPayloadStatus(),
+	)
+
+	return cmd
+}
+
+func CmdIdentityPayloadExport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [create-did|update-did] [payload-json]",
+		Short: "Export an unsigned payload to a signing file",
+		Long: "Export MsgCreateDidPayload or MsgUpdateDidPayload passed as JSON or a path to a JSON file. " +
+			"If versionId of an update is omitted, the version of the latest DID Doc state is queried from the node.",
+		Example: "export update-did payload.json --output-document unsigned.json",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payload, err := NewPayload(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := ReadPayload(clientCtx, args[1], payload); err != nil {
+				return err
+			}
+
+			if updatePayload, ok := payload.(*v1.MsgUpdateDidPayload); ok && len(updatePayload.VersionId) == 0 {
+				res, err := v1.NewQueryClient(clientCtx).Did(context.Background(), &v1.QueryGetDidRequest{Id: updatePayload.Id})
+				if err != nil {
+					return err
+				}
+
+				updatePayload.VersionId = res.Metadata.VersionId
+			}
+
+			msg, err := NewIdentityMsg(payload, nil)
+			if err != nil {
+				return err
+			}
+
+			return writeSigningFile(cmd, clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdIdentityPayloadSign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [signing-file]",
+		Short: "Append signatures to a signing file",
+		Long: "Sign the payload of a signing file with the keys passed by --" + FlagSignKeyFile + " or --" + FlagSignKeyring + ". " +
+			"The signatures are appended to the ones already in the file. It doesn't need a connection to a node.",
+		Example: "sign unsigned.json --sign-keyring did:cheqd:testnet:alice#key-1=alice-key-1 --output-document alice.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := ReadSigningFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			payload, signatures, err := SplitIdentityMsg(msg)
+			if err != nil {
+				return err
+			}
+
+			newSignatures, err := SignPayload(cmd, clientCtx, payload)
+			if err != nil {
+				return err
+			}
+
+			msg, err = NewIdentityMsg(payload, MergeSignInfos(signatures, newSignatures))
+			if err != nil {
+				return err
+			}
+
+			return writeSigningFile(cmd, clientCtx, msg)
+		},
+	}
+
+	AddSignFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+func CmdIdentityPayloadMerge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "merge [signing-file] [signing-file]...",
+		Short:   "Merge the signatures of signing files",
+		Long:    "Merge the signatures of signing files. All the files should contain the same payload.",
+		Example: "merge alice.json bob.json --output-document signed.json",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs := make([]sdk.Msg, 0, len(args))
+			for _, file := range args {
+				msg, err := ReadSigningFile(clientCtx, file)
+				if err != nil {
+					return fmt.Errorf("%s: %w", file, err)
+				}
+
+				msgs = append(msgs, msg)
+			}
+
+			msg, err := MergeIdentityMsgs(msgs)
+			if err != nil {
+				return err
+			}
+
+			return writeSigningFile(cmd, clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+func CmdIdentityPayloadStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [signing-file]",
+		Short: "Show which required signers are still missing",
+		Long: "Show which signers are required by the payload of a signing file and whether their signatures are present and valid. " +
+			"The required signers are worked out by the node rules, so the current DID Docs are queried from the node.",
+		Example: "status signed.json --node tcp://localhost:26657",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := ReadSigningFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			status, err := GetSigningStatus(context.Background(), v1.NewQueryClient(clientCtx), msg)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(status)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewIdentityMsg returns the identity message of the payload
+func NewIdentityMsg(payload IdentityPayload, signatures []*v1.SignInfo) (sdk.Msg, error) {
+	switch payload := payload.(type) {
+	case *v1.MsgCreateDidPayload:
+		return v1.NewMsgCreateDid(payload, signatures), nil
+	case *v1.MsgUpdateDidPayload:
+		return v1.NewMsgUpdateDid(payload, signatures), nil
+	default:
+		return nil, fmt.Errorf("unsupported payload type %T", payload)
+	}
+}
+
+// SplitIdentityMsg returns the payload and the signatures of the identity message
+func SplitIdentityMsg(msg sdk.Msg) (IdentityPayload, []*v1.SignInfo, error) {
+	switch msg := msg.(type) {
+	case *v1.MsgCreateDid:
+		return msg.Payload, msg.Signatures, nil
+	case *v1.MsgUpdateDid:
+		return msg.Payload, msg.Signatures, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported message type %T", msg)
+	}
+}
+
+// ReadSigningFile reads an identity message from a signing file
+func ReadSigningFile(clientCtx client.Context, file string) (sdk.Msg, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+		return nil, fmt.Errorf("failed to parse signing file: %w", err)
+	}
+
+	if _, _, err := SplitIdentityMsg(msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// MarshalSigningFile marshals an identity message with its type, so that the payload type is kept
+func MarshalSigningFile(clientCtx client.Context, msg sdk.Msg) ([]byte, error) {
+	bz, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, bz, "", "  "); err != nil {
+		return nil, err
+	}
+
+	out.WriteString("\n")
+	return out.Bytes(), nil
+}
+
+// MergeIdentityMsgs merges the signatures of identity messages with the same payload
+func MergeIdentityMsgs(msgs []sdk.Msg) (sdk.Msg, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("at least one message should be passed")
+	}
+
+	payload, signatures, err := SplitIdentityMsg(msgs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range msgs[1:] {
+		otherPayload, otherSignatures, err := SplitIdentityMsg(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		if reflect.TypeOf(otherPayload) != reflect.TypeOf(payload) || !bytes.Equal(otherPayload.GetSignBytes(), payload.GetSignBytes()) {
+			return nil, fmt.Errorf("signing files contain different payloads")
+		}
+
+		signatures = MergeSignInfos(signatures, otherSignatures)
+	}
+
+	return NewIdentityMsg(payload, signatures)
+}
+
+// MergeSignInfos appends the signatures which are not present yet
+func MergeSignInfos(signatures []*v1.SignInfo, newSignatures []*v1.SignInfo) []*v1.SignInfo {
+	result := append([]*v1.SignInfo{}, signatures...)
+
+	for _, signature := range newSignatures {
+		if !containsSignInfo(result, signature) {
+			result = append(result, signature)
+		}
+	}
+
+	return result
+}
+
+// GetSigningStatus checks the signatures of the identity message against the signers the node requires.
+// The signers are worked out like in the msg server, including the rules of `VerifySignatureOnDidUpdate`.
+func GetSigningStatus(ctx context.Context, queryClient v1.QueryClient, msg sdk.Msg) (*SigningStatus, error) {
+	payload, signatures, err := SplitIdentityMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := payload.GetSigners()
+
+	if updatePayload, ok := payload.(*v1.MsgUpdateDidPayload); ok {
+		res, err := queryClient.Did(ctx, &v1.QueryGetDidRequest{Id: updatePayload.Id})
+		if err != nil {
+			return nil, err
+		}
+
+		signers = v1.GetSignersOnDidUpdate(res.Did, updatePayload)
+	}
+
+	status := SigningStatus{
+		Complete: len(signers) > 0,
+		Signers:  []SignerStatus{},
+	}
+
+	signBytes := payload.GetSignBytes()
+
+	for _, signer := range signers {
+		if signer.VerificationMethod == nil {
+			res, err := queryClient.Did(ctx, &v1.QueryGetDidRequest{Id: signer.Signer})
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", signer.Signer, err)
+			}
+
+			signer.Authentication = res.Did.Authentication
+			signer.VerificationMethod = res.Did.VerificationMethod
+		}
+
+		signerStatus := getSignerStatus(signer, signatures, signBytes)
+		status.Complete = status.Complete && signerStatus.Status == SignerStatusSigned
+		status.Signers = append(status.Signers, signerStatus)
+	}
+
+	return &status, nil
+}
+
+func getSignerStatus(signer v1.Signer, signatures []*v1.SignInfo, signBytes []byte) SignerStatus {
+	status := SignerStatus{Did: signer.Signer, Status: SignerStatusMissing}
+
+	for _, signature := range signatures {
+		if did, _ := utils.SplitDidUrlIntoDidAndFragment(signature.VerificationMethodId); did == signer.Signer {
+			status.Status = SignerStatusSigned
+		}
+	}
+
+	if status.Status == SignerStatusMissing {
+		return status
+	}
+
+	valid, err := v1.VerifyIdentitySignature(signer, signatures, signBytes)
+	if err != nil {
+		status.Status = SignerStatusInvalid
+		status.Error = err.Error()
+	} else if !valid {
+		status.Status = SignerStatusInvalid
+		status.Error = "signature verification failed"
+	}
+
+	return status
+}
+
+func containsSignInfo(signatures []*v1.SignInfo, signature *v1.SignInfo) bool {
+	for _, s := range signatures {
+		if s.VerificationMethodId == signature.VerificationMethodId && s.Signature == signature.Signature {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeSigningFile(cmd *cobra.Command, clientCtx client.Context, msg sdk.Msg) error {
+	bz, err := MarshalSigningFile(clientCtx, msg)
+	if err != nil {
+		return err
+	}
+
+	file, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if err != nil {
+		return err
+	}
+
+	if len(file) == 0 {
+		return clientCtx.PrintBytes(bz)
+	}
+
+	return ioutil.WriteFile(file, bz, 0600)
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/cheqd/cheqd-node/app/params"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningFile(t *testing.T) {
+	encodingConfig := params.MakeEncodingConfig()
+	v1.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	clientCtx := client.Context{}.WithCodec(encodingConfig.Codec)
+
+	signature := &v1.SignInfo{VerificationMethodId: "did:cheqd:test:alice#key-1", Signature: "c2lnbmF0dXJl"}
+	msg, err := NewIdentityMsg(&v1.MsgUpdateDidPayload{Id: "did:cheqd:test:alice", VersionId: "1"}, []*v1.SignInfo{signature})
+	require.Nil(t, err)
+
+	bz, err := MarshalSigningFile(clientCtx, msg)
+	require.Nil(t, err)
+	require.Contains(t, string(bz), `"@type": "/cheqdid.cheqdnode.cheqd.v1.MsgUpdateDid"`)
+
+	file := filepath.Join(t.TempDir(), "signing.json")
+	require.Nil(t, ioutil.WriteFile(file, bz, 0600))
+
+	read, err := ReadSigningFile(clientCtx, file)
+	require.Nil(t, err)
+	require.Equal(t, msg, read)
+
+	payload, signatures, err := SplitIdentityMsg(read)
+	require.Nil(t, err)
+	require.Equal(t, "1", payload.(*v1.MsgUpdateDidPayload).VersionId)
+	require.Equal(t, []*v1.SignInfo{signature}, signatures)
+
+	_, _, err = SplitIdentityMsg(&v1.MsgDeactivateDid{})
+	require.Error(t, err)
+}
+
+func TestMergeSignInfos(t *testing.T) {
+	alice := &v1.SignInfo{VerificationMethodId: "did:cheqd:test:alice#key-1", Signature: "YWxpY2U="}
+	bob := &v1.SignInfo{VerificationMethodId: "did:cheqd:test:bob#key-1", Signature: "Ym9i"}
+
+	signatures := []*v1.SignInfo{alice}
+	merged := MergeSignInfos(signatures, []*v1.SignInfo{{VerificationMethodId: alice.VerificationMethodId, Signature: alice.Signature}, bob})
+
+	require.Equal(t, []*v1.SignInfo{alice, bob}, merged)
+	require.Len(t, signatures, 1)
+}
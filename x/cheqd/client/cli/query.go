@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(_ string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        v1.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", v1.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdParams(),
+		CmdGetDid(),
+		CmdSimulateDidUpdate(),
+	)
+
+	return cmd
+}
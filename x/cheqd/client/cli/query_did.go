@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetDid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "did [id]",
+		Short:   "Query a DID Doc and its metadata",
+		Example: "did did:cheqd:testnet:zF7rhDBfUt9d1gJPjx7s1JXfUY7oVWkY",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Did(context.Background(), &v1.QueryGetDidRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSimulateDidUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-did-update [signing-file]",
+		Short: "Check a DID Doc update without sending it",
+		Long: "Run the checks of a DID Doc update against the current state. The signing file is produced by `identity payload` commands. " +
+			"The result lists the DIDs which have to sign the update, the verification result of every signature and the error the update would fail with.",
+		Example: "simulate-did-update signed.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := ReadSigningFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			updateMsg, ok := msg.(*v1.MsgUpdateDid)
+			if !ok {
+				return fmt.Errorf("signing file should contain an update-did payload, got %T", msg)
+			}
+
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateDidUpdate(context.Background(), &v1.QuerySimulateDidUpdateRequest{
+				Payload:    updateMsg.Payload,
+				Signatures: updateMsg.Signatures,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the module params",
+		Long:  "Query the allowed verification method and service types and the DID Doc limits. They are changed by `ParameterChangeProposal`.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &v1.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        v1.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", v1.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdCreateDid(),
+		CmdUpdateDid(),
+		CmdSendSignedPayload(),
+	)
+
+	return cmd
+}
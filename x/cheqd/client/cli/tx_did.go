@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreateDid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-did [payload-json]",
+		Short: "Create a new DID Doc",
+		Long: "Create a new DID Doc. The payload is MsgCreateDidPayload as JSON or a path to a JSON file. " +
+			"It is signed with the keys of the verification methods passed by --" + FlagSignKeyFile + " or --" + FlagSignKeyring + ".",
+		Example: "create-did payload.json --sign-key-file did:cheqd:testnet:alice#key-1=alice-key-1.txt --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var payload v1.MsgCreateDidPayload
+			if err := ReadPayload(clientCtx, args[0], &payload); err != nil {
+				return err
+			}
+
+			signatures, err := SignPayload(cmd, clientCtx, &payload)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgCreateDid(&payload, signatures)
+			return BroadcastIdentityTx(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	AddSignFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateDid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-did [payload-json]",
+		Short: "Update a DID Doc",
+		Long: "Update a DID Doc. The payload is MsgUpdateDidPayload as JSON or a path to a JSON file. " +
+			"If versionId is omitted, the version of the latest DID Doc state is used. " +
+			"It is signed with the keys of the verification methods passed by --" + FlagSignKeyFile + " or --" + FlagSignKeyring + ".",
+		Example: "update-did payload.json --sign-keyring did:cheqd:testnet:alice#key-1=alice-key-1 --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var payload v1.MsgUpdateDidPayload
+			if err := ReadPayload(clientCtx, args[0], &payload); err != nil {
+				return err
+			}
+
+			if len(payload.VersionId) == 0 {
+				res, err := v1.NewQueryClient(clientCtx).Did(context.Background(), &v1.QueryGetDidRequest{Id: payload.Id})
+				if err != nil {
+					return err
+				}
+
+				payload.VersionId = res.Metadata.VersionId
+			}
+
+			signatures, err := SignPayload(cmd, clientCtx, &payload)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUpdateDid(&payload, signatures)
+			return BroadcastIdentityTx(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	AddSignFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSendSignedPayload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-signed-payload [signing-file]",
+		Short: "Send a DID payload signed by `identity payload` commands",
+		Long: "Send the identity message of a signing file produced by `identity payload sign` or `identity payload merge`. " +
+			"Use `identity payload status` to check that no required signer is missing before sending it.",
+		Example: "send-signed-payload signed.json --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := ReadSigningFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return BroadcastIdentityTx(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,276 @@
+package cli
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	FlagSignKeyFile = "sign-key-file"
+	FlagSignKeyring = "sign-keyring"
+)
+
+// AddSignFlagsToCmd adds flags for the keys signing identity payloads
+func AddSignFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().StringArray(FlagSignKeyFile, []string{},
+		"Verification method id and the path to a file with a base64 encoded ed25519 private key or seed: <vm-id>=<file>. Can be repeated")
+	cmd.Flags().StringArray(FlagSignKeyring, []string{},
+		"Verification method id and the name of an ed25519 key in the keyring: <vm-id>=<name>. Can be repeated")
+}
+
+// ReadPayload reads the payload from a JSON string or a path to a JSON file
+func ReadPayload(clientCtx client.Context, payloadOrFile string, payload proto.Message) error {
+	bytes := []byte(payloadOrFile)
+
+	if _, err := os.Stat(payloadOrFile); err == nil {
+		bytes, err = ioutil.ReadFile(payloadOrFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := clientCtx.Codec.UnmarshalJSON(bytes, payload); err != nil {
+		return fmt.Errorf("failed to parse payload: %w", err)
+	}
+
+	return nil
+}
+
+// SignPayload signs the payload with the keys passed by the sign flags
+func SignPayload(cmd *cobra.Command, clientCtx client.Context, payload v1.IdentityMsg) ([]*v1.SignInfo, error) {
+	signBytes := payload.GetSignBytes()
+	var signatures []*v1.SignInfo
+
+	// Private keys are read from files, so they don't leak to the shell history and the process list
+	keyFiles, err := cmd.Flags().GetStringArray(FlagSignKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range keyFiles {
+		vmId, file, err := SplitSignFlag(value)
+		if err != nil {
+			return nil, err
+		}
+
+		privKey, err := ReadPrivateKeyFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", vmId, err)
+		}
+
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: vmId,
+			Signature:            base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signBytes)),
+		})
+	}
+
+	keyNames, err := cmd.Flags().GetStringArray(FlagSignKeyring)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range keyNames {
+		vmId, name, err := SplitSignFlag(value)
+		if err != nil {
+			return nil, err
+		}
+
+		signature, pubKey, err := clientCtx.Keyring.Sign(name, signBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if pubKey.Type() != "ed25519" {
+			return nil, fmt.Errorf("%s: key %s should be ed25519, got %s", vmId, name, pubKey.Type())
+		}
+
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: vmId,
+			Signature:            base64.StdEncoding.EncodeToString(signature),
+		})
+	}
+
+	if len(signatures) == 0 {
+		return nil, fmt.Errorf("at least one key should be passed by --%s or --%s", FlagSignKeyFile, FlagSignKeyring)
+	}
+
+	return signatures, nil
+}
+
+// SplitSignFlag splits `<vm-id>=<value>`. Verification method ids can't contain '='.
+func SplitSignFlag(value string) (string, string, error) {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("%s: expected <vm-id>=<value>", value)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// ReadPrivateKeyFile reads a base64 encoded ed25519 private key or its seed from the file
+func ReadPrivateKeyFile(file string) (ed25519.PrivateKey, error) {
+	bytes, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePrivateKey(strings.TrimSpace(string(bytes)))
+}
+
+// ParsePrivateKey decodes a base64 encoded ed25519 private key or its seed
+func ParsePrivateKey(encoded string) (ed25519.PrivateKey, error) {
+	bytes, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(bytes) {
+	case ed25519.PrivateKeySize:
+		return bytes, nil
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(bytes), nil
+	default:
+		return nil, fmt.Errorf("ed25519 private key should be %d or %d bytes, got %d", ed25519.PrivateKeySize, ed25519.SeedSize, len(bytes))
+	}
+}
+
+// BroadcastIdentityTx generates or broadcasts a tx with an identity message.
+// Identity messages don't have Cosmos signers, so the account passed by --from pays fees and signs the tx.
+func BroadcastIdentityTx(clientCtx client.Context, flagSet *pflag.FlagSet, msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	txf := tx.NewFactoryCLI(clientCtx, flagSet)
+	from := clientCtx.GetFromAddress()
+
+	if clientCtx.GenerateOnly {
+		txBuilder, err := buildIdentityTx(txf, from, msg)
+		if err != nil {
+			return err
+		}
+
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+	}
+
+	if err := txf.AccountRetriever().EnsureExists(clientCtx, from); err != nil {
+		return err
+	}
+
+	if txf.AccountNumber() == 0 || txf.Sequence() == 0 {
+		num, seq, err := txf.AccountRetriever().GetAccountNumberSequence(clientCtx, from)
+		if err != nil {
+			return err
+		}
+
+		if txf.AccountNumber() == 0 {
+			txf = txf.WithAccountNumber(num)
+		}
+
+		if txf.Sequence() == 0 {
+			txf = txf.WithSequence(seq)
+		}
+	}
+
+	if txf.SimulateAndExecute() || clientCtx.Simulate {
+		gasUsed, err := simulateIdentityTx(clientCtx, txf, from, msg)
+		if err != nil {
+			return err
+		}
+
+		txf = txf.WithGas(uint64(txf.GasAdjustment() * float64(gasUsed)))
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", tx.GasEstimateResponse{GasEstimate: txf.Gas()})
+	}
+
+	if clientCtx.Simulate {
+		return nil
+	}
+
+	txBuilder, err := buildIdentityTx(txf, from, msg)
+	if err != nil {
+		return err
+	}
+
+	txBuilder.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+func buildIdentityTx(txf tx.Factory, feePayer sdk.AccAddress, msg sdk.Msg) (client.TxBuilder, error) {
+	txBuilder, err := tx.BuildUnsignedTx(txf, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	payerSetter, ok := txBuilder.(interface{ SetFeePayer(sdk.AccAddress) })
+	if !ok {
+		return nil, fmt.Errorf("tx builder doesn't support fee payers")
+	}
+
+	payerSetter.SetFeePayer(feePayer)
+	return txBuilder, nil
+}
+
+func simulateIdentityTx(clientCtx client.Context, txf tx.Factory, feePayer sdk.AccAddress, msg sdk.Msg) (uint64, error) {
+	txBuilder, err := buildIdentityTx(txf, feePayer, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	// The ante handler populates the empty signature with a sentinel pubkey
+	sig := signing.SignatureV2{
+		PubKey:   &secp256k1.PubKey{},
+		Data:     &signing.SingleSignatureData{SignMode: txf.SignMode()},
+		Sequence: txf.Sequence(),
+	}
+
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return 0, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return 0, err
+	}
+
+	simRes, err := txtypes.NewServiceClient(clientCtx).Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, err
+	}
+
+	return simRes.GasInfo.GasUsed, nil
+}
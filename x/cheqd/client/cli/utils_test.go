@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/cheqd/cheqd-node/app/params"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitSignFlag(t *testing.T) {
+	vmId, value, err := SplitSignFlag("did:cheqd:test:alice#key-1=abc==")
+	require.Nil(t, err)
+	require.Equal(t, "did:cheqd:test:alice#key-1", vmId)
+	require.Equal(t, "abc==", value)
+
+	_, _, err = SplitSignFlag("did:cheqd:test:alice#key-1")
+	require.Error(t, err)
+
+	_, _, err = SplitSignFlag("=abc")
+	require.Error(t, err)
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	_, privKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	parsed, err := ParsePrivateKey(base64.StdEncoding.EncodeToString(privKey))
+	require.Nil(t, err)
+	require.Equal(t, privKey, parsed)
+
+	parsed, err = ParsePrivateKey(base64.StdEncoding.EncodeToString(privKey.Seed()))
+	require.Nil(t, err)
+	require.Equal(t, privKey, parsed)
+
+	_, err = ParsePrivateKey(base64.StdEncoding.EncodeToString([]byte{1, 2, 3}))
+	require.EqualError(t, err, "ed25519 private key should be 64 or 32 bytes, got 3")
+}
+
+func TestReadAndSignPayload(t *testing.T) {
+	encodingConfig := params.MakeEncodingConfig()
+	clientCtx := client.Context{}.WithCodec(encodingConfig.Codec)
+
+	payloadJson := `{"id": "did:cheqd:test:alice", "authentication": ["did:cheqd:test:alice#key-1"]}`
+	file := filepath.Join(t.TempDir(), "payload.json")
+	require.Nil(t, ioutil.WriteFile(file, []byte(payloadJson), 0600))
+
+	for _, input := range []string{payloadJson, file} {
+		var payload v1.MsgCreateDidPayload
+		require.Nil(t, ReadPayload(clientCtx, input, &payload))
+		require.Equal(t, "did:cheqd:test:alice", payload.Id)
+		require.Equal(t, []string{"did:cheqd:test:alice#key-1"}, payload.Authentication)
+	}
+
+	var payload v1.MsgCreateDidPayload
+	require.Error(t, ReadPayload(clientCtx, "{", &payload))
+
+	pubKey, privKey, _ := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, ReadPayload(clientCtx, payloadJson, &payload))
+
+	cmd := &cobra.Command{}
+	AddSignFlagsToCmd(cmd)
+	keyFile := filepath.Join(t.TempDir(), "key.txt")
+	require.Nil(t, ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(privKey)+"\n"), 0600))
+	require.Nil(t, cmd.Flags().Set(FlagSignKeyFile, "did:cheqd:test:alice#key-1="+keyFile))
+
+	signatures, err := SignPayload(cmd, clientCtx, &payload)
+	require.Nil(t, err)
+	require.Len(t, signatures, 1)
+	require.Equal(t, "did:cheqd:test:alice#key-1", signatures[0].VerificationMethodId)
+
+	signature, _ := base64.StdEncoding.DecodeString(signatures[0].Signature)
+	require.True(t, ed25519.Verify(pubKey, payload.GetSignBytes(), signature))
+
+	_, err = SignPayload(&cobra.Command{}, clientCtx, &payload)
+	require.Error(t, err)
+}
+
+func TestBuildIdentityTx(t *testing.T) {
+	encodingConfig := params.MakeEncodingConfig()
+	v1.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	feePayer := sdk.AccAddress("fee_payer___________")
+	txf := tx.Factory{}.WithTxConfig(encodingConfig.TxConfig).WithChainID("test").WithGas(200000)
+
+	msg := v1.NewMsgCreateDid(&v1.MsgCreateDidPayload{Id: "did:cheqd:test:alice"}, nil)
+	txBuilder, err := buildIdentityTx(txf, feePayer, msg)
+
+	require.Nil(t, err)
+	require.Equal(t, []sdk.AccAddress{feePayer}, txBuilder.GetTx().GetSigners())
+}
@@ -0,0 +1,209 @@
+package rest
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// ResourcesPath is the DID URL path of resources linked to the DID
+const ResourcesPath = "/resources/"
+
+// DID URL query parameters, see https://www.w3.org/TR/did-core/#did-parameters
+const (
+	VersionIdParam   = "versionId"
+	VersionTimeParam = "versionTime"
+	ServiceParam     = "service"
+	RelativeRefParam = "relativeRef"
+)
+
+// DereferenceDidUrl dereferences the DID URL using the `Query/Did*` and `Query/Resource*` endpoints.
+// Returns the DID URL Dereferencing Result and the matching HTTP status code.
+func DereferenceDidUrl(ctx context.Context, queryClient v1.QueryClient, didUrl string) (*v1.DidDereferencingResult, int) {
+	parsed, err := utils.ParseDidUrl(didUrl)
+	if err != nil {
+		return v1.NewDidDereferencingError(v1.ResolutionInvalidDidUrl, time.Now()), http.StatusBadRequest
+	}
+
+	if method, _, _ := utils.SplitDid(parsed.Did); method != v1.DidMethod {
+		return v1.NewDidDereferencingError(v1.ResolutionMethodNotSupported, time.Now()), http.StatusNotImplemented
+	}
+
+	if len(parsed.Path) > 0 {
+		return dereferenceResource(ctx, queryClient, parsed)
+	}
+
+	did, metadata, err := getDidDocVersion(ctx, queryClient, parsed)
+	if err != nil {
+		if err == errInvalidDidUrl {
+			return v1.NewDidDereferencingError(v1.ResolutionInvalidDidUrl, time.Now()), http.StatusBadRequest
+		}
+
+		code, status := getResolutionError(err)
+		return v1.NewDidDereferencingError(code, time.Now()), status
+	}
+
+	didDocMetadata, err := v1.NewDidDocumentMetadata(metadata)
+	if err != nil {
+		return v1.NewDidDereferencingError(v1.ResolutionInternalError, time.Now()), http.StatusInternalServerError
+	}
+
+	didDoc := v1.NewDidDocument(did)
+
+	if service := parsed.Query.Get(ServiceParam); len(service) > 0 {
+		endpoint, err := getServiceEndpoint(&didDoc, service, parsed.Query.Get(RelativeRefParam), parsed.Fragment)
+		if err != nil {
+			return v1.NewDidDereferencingError(v1.ResolutionNotFound, time.Now()), http.StatusNotFound
+		}
+
+		return v1.NewDidDereferencingResult(v1.UriListContentType, endpoint, didDocMetadata, time.Now()), http.StatusOK
+	}
+
+	if len(parsed.Fragment) > 0 {
+		content := findDidDocFragment(&didDoc, parsed.Fragment)
+		if content == nil {
+			return v1.NewDidDereferencingError(v1.ResolutionNotFound, time.Now()), http.StatusNotFound
+		}
+
+		return v1.NewDidDereferencingResult(v1.DidLdJsonContentType, content, didDocMetadata, time.Now()), http.StatusOK
+	}
+
+	return v1.NewDidDereferencingResult(v1.DidLdJsonContentType, didDoc, didDocMetadata, time.Now()), http.StatusOK
+}
+
+var errInvalidDidUrl = v1.ErrBadRequest.Wrap("invalid DID URL")
+
+// getDidDocVersion returns the DID Doc version selected by `versionId` or `versionTime`, the latest one by default
+func getDidDocVersion(ctx context.Context, queryClient v1.QueryClient, didUrl *utils.DidUrl) (*v1.Did, *v1.Metadata, error) {
+	versionId := didUrl.Query.Get(VersionIdParam)
+	versionTime := didUrl.Query.Get(VersionTimeParam)
+
+	switch {
+	case len(versionId) > 0 && len(versionTime) > 0:
+		return nil, nil, errInvalidDidUrl
+
+	case len(versionId) > 0:
+		res, err := queryClient.DidVersion(ctx, &v1.QueryGetDidVersionRequest{Id: didUrl.Did, VersionId: versionId})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return res.Did, res.Metadata, nil
+
+	case len(versionTime) > 0:
+		if _, err := time.Parse(time.RFC3339, versionTime); err != nil {
+			return nil, nil, errInvalidDidUrl
+		}
+
+		res, err := queryClient.DidAtTime(ctx, &v1.QueryGetDidAtTimeRequest{Id: didUrl.Did, Timestamp: versionTime})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return res.Did, res.Metadata, nil
+
+	default:
+		res, err := queryClient.Did(ctx, &v1.QueryGetDidRequest{Id: didUrl.Did})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return res.Did, res.Metadata, nil
+	}
+}
+
+// dereferenceResource returns data of the resource selected by the `/resources/<id>` path
+func dereferenceResource(ctx context.Context, queryClient v1.QueryClient, didUrl *utils.DidUrl) (*v1.DidDereferencingResult, int) {
+	if !strings.HasPrefix(didUrl.Path, ResourcesPath) {
+		return v1.NewDidDereferencingError(v1.ResolutionNotFound, time.Now()), http.StatusNotFound
+	}
+
+	id := strings.TrimPrefix(didUrl.Path, ResourcesPath)
+	if !utils.IsValidUUID(id) {
+		return v1.NewDidDereferencingError(v1.ResolutionInvalidDidUrl, time.Now()), http.StatusBadRequest
+	}
+
+	header, err := queryClient.Resource(ctx, &v1.QueryGetResourceRequest{CollectionId: didUrl.Did, Id: id})
+	if err != nil {
+		code, status := getResolutionError(err)
+		return v1.NewDidDereferencingError(code, time.Now()), status
+	}
+
+	data, err := queryClient.ResourceData(ctx, &v1.QueryGetResourceDataRequest{CollectionId: didUrl.Did, Id: id})
+	if err != nil {
+		code, status := getResolutionError(err)
+		return v1.NewDidDereferencingError(code, time.Now()), status
+	}
+
+	metadata, err := v1.NewResourceMetadata(header.Resource)
+	if err != nil {
+		return v1.NewDidDereferencingError(v1.ResolutionInternalError, time.Now()), http.StatusInternalServerError
+	}
+
+	return v1.NewDidDereferencingResult(data.MediaType, data.Data, metadata, time.Now()), http.StatusOK
+}
+
+// getServiceEndpoint returns the endpoint of the service with the relative reference and fragment applied
+func getServiceEndpoint(didDoc *v1.DidDocument, service string, relativeRef string, fragment string) (string, error) {
+	for _, s := range didDoc.Service {
+		if utils.ResolveId(didDoc.Id, s.Id) != didDoc.Id+"#"+service {
+			continue
+		}
+
+		endpoint, err := url.Parse(s.ServiceEndpoint)
+		if err != nil {
+			return "", err
+		}
+
+		if len(relativeRef) > 0 {
+			ref, err := url.Parse(relativeRef)
+			if err != nil {
+				return "", err
+			}
+
+			endpoint = endpoint.ResolveReference(ref)
+		}
+
+		if len(fragment) > 0 {
+			endpoint.Fragment = fragment
+		}
+
+		return endpoint.String(), nil
+	}
+
+	return "", v1.ErrResourceNotFound.Wrap(service)
+}
+
+// findDidDocFragment returns the verification method or service identified by the fragment
+func findDidDocFragment(didDoc *v1.DidDocument, fragment string) interface{} {
+	id := didDoc.Id + "#" + fragment
+
+	for _, vm := range didDoc.VerificationMethod {
+		if utils.ResolveId(didDoc.Id, vm.Id) == id {
+			return vm
+		}
+	}
+
+	for _, service := range didDoc.Service {
+		if utils.ResolveId(didDoc.Id, service.Id) == id {
+			return service
+		}
+	}
+
+	return nil
+}
+
+func dereferenceDidUrlHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		didUrl := r.URL.Query().Get("didUrl")
+		result, status := DereferenceDidUrl(r.Context(), v1.NewQueryClient(clientCtx), didUrl)
+
+		WriteJSONResponse(w, v1.DidResolutionContentType, status, result)
+	}
+}
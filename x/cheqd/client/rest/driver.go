@@ -0,0 +1,232 @@
+package rest
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/gorilla/mux"
+)
+
+// DriverPath is the DIF Universal Resolver driver endpoint
+const DriverPath = "/1.0/identifiers/{identifier:.+}"
+
+const (
+	jsonLdContentType       = "application/ld+json"
+	didResolutionProfile    = "https://w3id.org/did-resolution"
+	representationErrorCode = "representationNotSupported"
+)
+
+// ResolverBackend provides query clients pinned to a block height
+type ResolverBackend interface {
+	LatestHeight(ctx context.Context) (int64, error)
+	QueryClient(height int64) v1.QueryClient
+}
+
+type clientResolverBackend struct {
+	clientCtx client.Context
+}
+
+// NewClientResolverBackend returns ResolverBackend querying the node of the client context
+func NewClientResolverBackend(clientCtx client.Context) ResolverBackend {
+	return clientResolverBackend{clientCtx: clientCtx}
+}
+
+func (b clientResolverBackend) LatestHeight(ctx context.Context) (int64, error) {
+	node, err := b.clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (b clientResolverBackend) QueryClient(height int64) v1.QueryClient {
+	return v1.NewQueryClient(b.clientCtx.WithHeight(height))
+}
+
+// ResolverDriver is a DIF Universal Resolver driver.
+// Resolution results are cached by DID for the latest block height.
+type ResolverDriver struct {
+	backend ResolverBackend
+	cache   *resolutionCache
+}
+
+func NewResolverDriver(backend ResolverBackend, cacheSize int) *ResolverDriver {
+	return &ResolverDriver{
+		backend: backend,
+		cache:   newResolutionCache(cacheSize),
+	}
+}
+
+func (d *ResolverDriver) RegisterRoutes(rtr *mux.Router) {
+	rtr.HandleFunc(DriverPath, d.handleIdentifier).Methods("GET")
+}
+
+// Resolve resolves the DID at the latest block height
+func (d *ResolverDriver) Resolve(ctx context.Context, did string) (*v1.DidResolutionResult, int) {
+	height, err := d.backend.LatestHeight(ctx)
+	if err != nil {
+		return v1.NewDidResolutionError(v1.ResolutionInternalError, time.Now()), http.StatusInternalServerError
+	}
+
+	if result, status, found := d.cache.Get(did, height); found {
+		return result, status
+	}
+
+	result, status := ResolveDid(ctx, d.backend.QueryClient(height), did)
+	if status != http.StatusInternalServerError {
+		d.cache.Set(did, height, result, status)
+	}
+
+	return result, status
+}
+
+func (d *ResolverDriver) handleIdentifier(w http.ResponseWriter, r *http.Request) {
+	identifier := mux.Vars(r)["identifier"]
+
+	contentType, ok := negotiateContentType(r.Header.Get("Accept"))
+	if !ok {
+		result := v1.NewDidResolutionError(representationErrorCode, time.Now())
+		WriteJSONResponse(w, v1.DidResolutionContentType, http.StatusNotAcceptable, result)
+		return
+	}
+
+	// DID URLs with a path, query or fragment are dereferenced
+	if _, _, isDid := utils.SplitDid(identifier); !isDid {
+		d.handleDidUrl(w, r, identifier, contentType)
+		return
+	}
+
+	result, status := d.Resolve(r.Context(), identifier)
+	if status != http.StatusOK || contentType == v1.DidResolutionContentType {
+		WriteJSONResponse(w, v1.DidResolutionContentType, status, result)
+		return
+	}
+
+	didDoc := *result.DidDocument
+	if contentType == v1.DidJsonContentType {
+		didDoc.Context = nil
+	}
+
+	WriteJSONResponse(w, contentType, status, didDoc)
+}
+
+func (d *ResolverDriver) handleDidUrl(w http.ResponseWriter, r *http.Request, didUrl string, contentType string) {
+	height, err := d.backend.LatestHeight(r.Context())
+	if err != nil {
+		result := v1.NewDidDereferencingError(v1.ResolutionInternalError, time.Now())
+		WriteJSONResponse(w, v1.DidResolutionContentType, http.StatusInternalServerError, result)
+		return
+	}
+
+	result, status := DereferenceDidUrl(r.Context(), d.backend.QueryClient(height), didUrl)
+	if status != http.StatusOK || contentType == v1.DidResolutionContentType {
+		WriteJSONResponse(w, v1.DidResolutionContentType, status, result)
+		return
+	}
+
+	switch content := result.ContentStream.(type) {
+	case []byte:
+		w.Header().Set("Content-Type", result.DereferencingMetadata.ContentType)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(content)
+	case string:
+		// Service endpoints are redirects in the HTTP(S) binding
+		http.Redirect(w, r, content, http.StatusSeeOther)
+	default:
+		WriteJSONResponse(w, contentType, status, content)
+	}
+}
+
+// negotiateContentType returns the first representation from the Accept header the driver supports.
+// The full resolution result is returned by default.
+func negotiateContentType(accept string) (string, bool) {
+	if len(strings.TrimSpace(accept)) == 0 {
+		return v1.DidResolutionContentType, true
+	}
+
+	for _, item := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case v1.DidLdJsonContentType, v1.DidJsonContentType:
+			return mediaType, true
+		case jsonLdContentType:
+			if profile, ok := params["profile"]; !ok || profile == didResolutionProfile {
+				return v1.DidResolutionContentType, true
+			}
+		case "application/json", "application/*", "*/*":
+			return v1.DidResolutionContentType, true
+		}
+	}
+
+	return "", false
+}
+
+type cachedResolution struct {
+	result *v1.DidResolutionResult
+	status int
+}
+
+// resolutionCache keeps resolution results of the latest block height.
+// Results of previous heights are dropped as soon as a new height is seen.
+type resolutionCache struct {
+	mu      sync.Mutex
+	size    int
+	height  int64
+	entries map[string]cachedResolution
+}
+
+func newResolutionCache(size int) *resolutionCache {
+	return &resolutionCache{
+		size:    size,
+		entries: map[string]cachedResolution{},
+	}
+}
+
+func (c *resolutionCache) Get(did string, height int64) (*v1.DidResolutionResult, int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height != c.height {
+		return nil, 0, false
+	}
+
+	entry, found := c.entries[did]
+	return entry.result, entry.status, found
+}
+
+func (c *resolutionCache) Set(did string, height int64, result *v1.DidResolutionResult, status int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height < c.height || c.size <= 0 {
+		return
+	}
+
+	if height > c.height {
+		c.height = height
+		c.entries = map[string]cachedResolution{}
+	}
+
+	if len(c.entries) >= c.size {
+		return
+	}
+
+	c.entries[did] = cachedResolution{result: result, status: status}
+}
@@ -0,0 +1,79 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gorilla/mux"
+)
+
+// ResolveDid resolves the DID using the `Query/Did` endpoint.
+// Returns the DID Resolution Result and the matching HTTP status code.
+func ResolveDid(ctx context.Context, queryClient v1.QueryClient, did string) (*v1.DidResolutionResult, int) {
+	method, _, ok := utils.SplitDid(did)
+	if !ok {
+		return v1.NewDidResolutionError(v1.ResolutionInvalidDid, time.Now()), http.StatusBadRequest
+	}
+
+	if method != v1.DidMethod {
+		return v1.NewDidResolutionError(v1.ResolutionMethodNotSupported, time.Now()), http.StatusNotImplemented
+	}
+
+	res, err := queryClient.Did(ctx, &v1.QueryGetDidRequest{Id: did})
+	if err != nil {
+		code, status := getResolutionError(err)
+		return v1.NewDidResolutionError(code, time.Now()), status
+	}
+
+	result, err := v1.NewDidResolutionResult(res.Did, res.Metadata, time.Now())
+	if err != nil {
+		return v1.NewDidResolutionError(v1.ResolutionInternalError, time.Now()), http.StatusInternalServerError
+	}
+
+	return result, http.StatusOK
+}
+
+func resolveDidHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		did := mux.Vars(r)["did"]
+		result, status := ResolveDid(r.Context(), v1.NewQueryClient(clientCtx), did)
+
+		WriteJSONResponse(w, v1.DidResolutionContentType, status, result)
+	}
+}
+
+// getResolutionError maps a query error to the DID Resolution error code and HTTP status code
+func getResolutionError(err error) (string, int) {
+	for _, notFound := range []*sdkerrors.Error{sdkerrors.ErrNotFound, v1.ErrDidDocNotFound, v1.ErrResourceNotFound} {
+		if isError(err, notFound) {
+			return v1.ResolutionNotFound, http.StatusNotFound
+		}
+	}
+
+	return v1.ResolutionInternalError, http.StatusInternalServerError
+}
+
+// isError compares ABCI codes because errors returned over gRPC are not the registered instances
+func isError(err error, target *sdkerrors.Error) bool {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	return codespace == target.Codespace() && code == target.ABCICode()
+}
+
+// WriteJSONResponse writes the value as JSON with the content type and status code
+func WriteJSONResponse(w http.ResponseWriter, contentType string, status int, value interface{}) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,12 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers cheqd REST routes that can't be served by the gRPC gateway
+func RegisterRoutes(clientCtx client.Context, rtr *mux.Router) {
+	rtr.HandleFunc("/cheqd/cheqdnode/cheqd/resolve/{did}", resolveDidHandler(clientCtx)).Methods("GET")
+	rtr.HandleFunc("/cheqd/cheqdnode/cheqd/dereference", dereferenceDidUrlHandler(clientCtx)).Methods("GET")
+}
@@ -10,21 +10,97 @@ import (
 // InitGenesis initializes the cheqd module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState v1.GenesisState) {
+	for _, elem := range genState.DidVersionList {
+		did, err := elem.GetDid()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		k.AppendDidVersion(ctx, did.Id, elem)
+	}
+
 	for _, elem := range genState.DidList {
 		did, err := elem.GetDid()
 		if err != nil {
 			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
 		}
 
+		k.UpdateDidControllerIndex(ctx, nil, did)
+		k.UpdateDidPublicKeyIndex(ctx, nil, did)
+
+		// History is already imported, only the latest state is left
+		if k.GetDidVersionCount(ctx, did.Id) > 0 {
+			k.SetDidStateValue(ctx, did.Id, elem)
+			continue
+		}
+
 		if err = k.SetDid(ctx, *did, elem.Metadata); err != nil {
 			panic(fmt.Sprintf("Cannot set did case: %s", err.Error()))
 		}
 	}
 
+	for _, elem := range genState.SchemaList {
+		schema, err := elem.GetSchema()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		k.SetSchemaStateValue(ctx, schema.Id, elem)
+	}
+
+	for _, elem := range genState.CredDefList {
+		credDef, err := elem.GetCredDef()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		k.SetCredDefStateValue(ctx, credDef, elem)
+	}
+
+	for _, elem := range genState.RevocRegDefList {
+		revocRegDef, err := elem.GetRevocRegDef()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		k.SetRevocRegDefStateValue(ctx, revocRegDef.Id, elem)
+	}
+
+	for _, elem := range genState.RevocRegEntryList {
+		revocRegEntry, err := elem.GetRevocRegEntry()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		k.AppendRevocRegEntryStateValue(ctx, revocRegEntry.RevocRegDefId, elem)
+	}
+
+	for _, elem := range genState.StatusListList {
+		statusList, err := elem.GetStatusList()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		if err := k.SetStatusList(ctx, *statusList, elem.Metadata); err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+	}
+
+	for _, elem := range genState.ResourceList {
+		k.SetResource(ctx, elem)
+	}
+
 	// Set nym count
 	k.SetDidCount(ctx, uint64(len(genState.DidList)))
 
 	k.SetDidNamespace(ctx, genState.DidNamespace)
+
+	params := v1.DefaultParams()
+	if genState.Params != nil {
+		params = *genState.Params
+	}
+
+	k.SetParams(ctx, params)
 }
 
 // ExportGenesis returns the cheqd module's exported genesis.
@@ -39,7 +115,59 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1.GenesisState {
 		genesis.DidList = append(genesis.DidList, &elem)
 	}
 
+	// Get all did versions
+	didVersionList := k.GetAllDidVersions(ctx)
+	for _, elem := range didVersionList {
+		elem := elem
+		genesis.DidVersionList = append(genesis.DidVersionList, &elem)
+	}
+
+	// Get all schemas
+	schemaList := k.GetAllSchemas(ctx)
+	for _, elem := range schemaList {
+		elem := elem
+		genesis.SchemaList = append(genesis.SchemaList, &elem)
+	}
+
+	// Get all cred defs
+	credDefList := k.GetAllCredDefs(ctx)
+	for _, elem := range credDefList {
+		elem := elem
+		genesis.CredDefList = append(genesis.CredDefList, &elem)
+	}
+
+	// Get all revoc reg defs
+	revocRegDefList := k.GetAllRevocRegDefs(ctx)
+	for _, elem := range revocRegDefList {
+		elem := elem
+		genesis.RevocRegDefList = append(genesis.RevocRegDefList, &elem)
+	}
+
+	// Get all revoc reg entries
+	revocRegEntryList := k.GetAllRevocRegEntries(ctx)
+	for _, elem := range revocRegEntryList {
+		elem := elem
+		genesis.RevocRegEntryList = append(genesis.RevocRegEntryList, &elem)
+	}
+
+	// Get all status lists
+	statusListList := k.GetAllStatusLists(ctx)
+	for _, elem := range statusListList {
+		elem := elem
+		genesis.StatusListList = append(genesis.StatusListList, &elem)
+	}
+
+	// Get all resources
+	resourceList := k.GetAllResources(ctx)
+	for _, elem := range resourceList {
+		elem := elem
+		genesis.ResourceList = append(genesis.ResourceList, &elem)
+	}
+
 	genesis.DidNamespace = k.GetDidNamespace(ctx)
 
+	params := k.GetParams(ctx)
+	genesis.Params = &params
+
 	return genesis
 }
@@ -25,6 +25,38 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.UpdateDid(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *v1.MsgDeactivateDid:
+			res, err := msgServer.DeactivateDid(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *v1.MsgCreateSchema:
+			res, err := msgServer.CreateSchema(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *v1.MsgCreateCredDef:
+			res, err := msgServer.CreateCredDef(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *v1.MsgCreateRevocRegDef:
+			res, err := msgServer.CreateRevocRegDef(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *v1.MsgCreateRevocRegEntry:
+			res, err := msgServer.CreateRevocRegEntry(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *v1.MsgCreateStatusList:
+			res, err := msgServer.CreateStatusList(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *v1.MsgUpdateStatusList:
+			res, err := msgServer.UpdateStatusList(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *v1.MsgCreateResource:
+			res, err := msgServer.CreateResource(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", v1.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -6,10 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetDidNamespace get the total number of did
+// GetDidNamespace get did namespace
 func (k Keeper) GetDidNamespace(ctx sdk.Context) string {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidNamespace))
-	byteKey := v1.KeyPrefix(v1.DidNamespace)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidNamespaceKey))
+	byteKey := v1.KeyPrefix(v1.DidNamespaceKey)
 	bz := store.Get(byteKey)
 
 	// Parse bytes
@@ -19,8 +19,8 @@ func (k Keeper) GetDidNamespace(ctx sdk.Context) string {
 
 // SetDidNamespace set did namespace
 func (k Keeper) SetDidNamespace(ctx sdk.Context, namespace string) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidNamespace))
-	byteKey := v1.KeyPrefix(v1.DidNamespace)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidNamespaceKey))
+	byteKey := v1.KeyPrefix(v1.DidNamespaceKey)
 	bz := []byte(namespace)
 	store.Set(byteKey, bz)
 }
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetCredDef set a specific cred def in the store and indexes it by the issuer DIDs and the tag
+func (k Keeper) SetCredDef(ctx sdk.Context, credDef v1.CredDef, metadata *v1.Metadata) error {
+	stateValue, err := v1.NewStateValue(&credDef, metadata)
+	if err != nil {
+		return v1.ErrSetToState.Wrap(err.Error())
+	}
+
+	k.SetCredDefStateValue(ctx, &credDef, stateValue)
+	return nil
+}
+
+// SetCredDefStateValue set the state of a specific cred def and its tag index
+func (k Keeper) SetCredDefStateValue(ctx sdk.Context, credDef *v1.CredDef, stateValue *v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.CredDefKey))
+	b := k.cdc.MustMarshal(stateValue)
+	store.Set(GetCredDefIDBytes(credDef.Id), b)
+
+	tagStore := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.CredDefTagKey))
+	for _, issuer := range credDef.Controller {
+		tagStore.Set(GetCredDefTagBytes(issuer, credDef.Tag), GetCredDefIDBytes(credDef.Id))
+	}
+}
+
+// GetCredDef returns a cred def from its id
+func (k Keeper) GetCredDef(ctx *sdk.Context, id string) (*v1.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.CredDefKey))
+
+	if !k.HasCredDef(*ctx, id) {
+		return nil, v1.ErrResourceNotFound.Wrap(id)
+	}
+
+	var value v1.StateValue
+	var bytes = store.Get(GetCredDefIDBytes(id))
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return &value, nil
+}
+
+// GetCredDefByTag returns a cred def from its issuer DID and tag
+func (k Keeper) GetCredDefByTag(ctx *sdk.Context, issuer string, tag string) (*v1.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.CredDefTagKey))
+
+	id := store.Get(GetCredDefTagBytes(issuer, tag))
+	if id == nil {
+		return nil, v1.ErrResourceNotFound.Wrapf("%s with tag %s", issuer, tag)
+	}
+
+	return k.GetCredDef(ctx, string(id))
+}
+
+// HasCredDef checks if the cred def exists in the store
+func (k Keeper) HasCredDef(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.CredDefKey))
+	return store.Has(GetCredDefIDBytes(id))
+}
+
+// HasCredDefTag checks if the issuer already has a cred def with the tag
+func (k Keeper) HasCredDefTag(ctx sdk.Context, issuer string, tag string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.CredDefTagKey))
+	return store.Has(GetCredDefTagBytes(issuer, tag))
+}
+
+// GetCredDefIDBytes returns the byte representation of the ID
+func GetCredDefIDBytes(id string) []byte {
+	return []byte(id)
+}
+
+// GetCredDefTagBytes returns the byte representation of the issuer and tag pair
+func GetCredDefTagBytes(issuer string, tag string) []byte {
+	return []byte(issuer + "/" + tag)
+}
+
+// GetAllCredDefs returns all cred defs
+func (k Keeper) GetAllCredDefs(ctx sdk.Context) (list []v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.CredDefKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val v1.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
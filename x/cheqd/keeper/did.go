@@ -51,17 +51,23 @@ func (k Keeper) AppendDid(ctx sdk.Context, did v1.Did, metadata *v1.Metadata) (*
 	return &did.Id, nil
 }
 
-// SetDid set a specific did in the store
+// SetDid set a specific did in the store and records it as a new did version
 func (k Keeper) SetDid(ctx sdk.Context, did v1.Did, metadata *v1.Metadata) error {
 	stateValue, err := v1.NewStateValue(&did, metadata)
 	if err != nil {
 		return v1.ErrSetToState.Wrap(err.Error())
 	}
 
+	k.SetDidStateValue(ctx, did.Id, stateValue)
+	k.AppendDidVersion(ctx, did.Id, stateValue)
+	return nil
+}
+
+// SetDidStateValue set the latest state of a specific did without recording a new version
+func (k Keeper) SetDidStateValue(ctx sdk.Context, id string, stateValue *v1.StateValue) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidKey))
 	b := k.cdc.MustMarshal(stateValue)
-	store.Set(GetDidIDBytes(did.Id), b)
-	return nil
+	store.Set(GetDidIDBytes(id), b)
 }
 
 // GetDid returns a did from its id
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils/strings"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateDidControllerIndex updates the index of DIDs by controller when the DID Doc changes from oldDid to newDid.
+// oldDid is nil for new DID Docs.
+func (k Keeper) UpdateDidControllerIndex(ctx sdk.Context, oldDid *v1.Did, newDid *v1.Did) {
+	var oldControllers []string
+	if oldDid != nil {
+		oldControllers = oldDid.GetControllers()
+	}
+
+	newControllers := newDid.GetControllers()
+
+	for _, controller := range strings.Complement(oldControllers, newControllers) {
+		k.didControllerStore(ctx, controller).Delete(GetDidIDBytes(newDid.Id))
+	}
+
+	for _, controller := range strings.Complement(newControllers, oldControllers) {
+		k.didControllerStore(ctx, controller).Set(GetDidIDBytes(newDid.Id), GetDidIDBytes(newDid.Id))
+	}
+}
+
+// BackfillDidControllerIndex indexes the controllers of all stored DID Docs.
+// Store migrations use it for DID Docs written before the index existed.
+func (k Keeper) BackfillDidControllerIndex(ctx sdk.Context) error {
+	for _, state := range k.GetAllDid(ctx) {
+		did, err := state.GetDid()
+		if err != nil {
+			return err
+		}
+
+		k.UpdateDidControllerIndex(ctx, nil, did)
+	}
+
+	return nil
+}
+
+// ControlsOtherDids checks if the DID controls any DID Doc besides its own
+func (k Keeper) ControlsOtherDids(ctx sdk.Context, controller string) bool {
+	iterator := k.didControllerStore(ctx, controller).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if string(iterator.Value()) != controller {
+			return true
+		}
+	}
+
+	return false
+}
+
+// didControllerStore returns the index of DIDs controlled by the controller.
+// DIDs can't contain '/', so indices of different controllers never overlap.
+func (k Keeper) didControllerStore(ctx sdk.Context, controller string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(v1.KeyPrefix(v1.DidControllerKey), GetDidIDBytes(controller+"/")...))
+}
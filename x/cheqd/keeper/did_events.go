@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils/strings"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+func NewEventDidCreated(did *v1.Did, metadata *v1.Metadata) *v1.EventDidCreated {
+	return &v1.EventDidCreated{
+		Did:                 did.Id,
+		VersionId:           metadata.VersionId,
+		Controllers:         did.Controller,
+		VerificationMethods: GetVerificationMethodIds(did.VerificationMethod),
+	}
+}
+
+// NewEventDidUpdated returns the event listing the controllers and the verification methods changed by the update
+func NewEventDidUpdated(oldDid *v1.Did, oldMetadata *v1.Metadata, newDid *v1.Did, newMetadata *v1.Metadata) *v1.EventDidUpdated {
+	event := v1.EventDidUpdated{
+		Did:                newDid.Id,
+		VersionId:          newMetadata.VersionId,
+		PreviousVersionId:  oldMetadata.VersionId,
+		AddedControllers:   strings.Complement(newDid.Controller, oldDid.Controller),
+		RemovedControllers: strings.Complement(oldDid.Controller, newDid.Controller),
+	}
+
+	for _, newVM := range newDid.VerificationMethod {
+		oldVM := v1.FindVerificationMethod(oldDid.VerificationMethod, newVM.Id)
+
+		if oldVM == nil {
+			event.AddedVerificationMethods = append(event.AddedVerificationMethods, newVM.Id)
+			continue
+		}
+
+		if !reflect.DeepEqual(oldVM, newVM) {
+			event.ChangedVerificationMethods = append(event.ChangedVerificationMethods, newVM.Id)
+		}
+	}
+
+	for _, oldVM := range oldDid.VerificationMethod {
+		if v1.FindVerificationMethod(newDid.VerificationMethod, oldVM.Id) == nil {
+			event.RemovedVerificationMethods = append(event.RemovedVerificationMethods, oldVM.Id)
+		}
+	}
+
+	return &event
+}
+
+func NewEventDidDeactivated(did *v1.Did, oldMetadata *v1.Metadata, newMetadata *v1.Metadata) *v1.EventDidDeactivated {
+	return &v1.EventDidDeactivated{
+		Did:               did.Id,
+		VersionId:         newMetadata.VersionId,
+		PreviousVersionId: oldMetadata.VersionId,
+	}
+}
+
+func GetVerificationMethodIds(vms []*v1.VerificationMethod) []string {
+	ids := make([]string, 0, len(vms))
+	for _, vm := range vms {
+		ids = append(ids, vm.Id)
+	}
+
+	return ids
+}
+
+// EmitDidEvent emits the typed event of a DID operation along with the untyped event of the DID
+func EmitDidEvent(ctx sdk.Context, did string, event proto.Message) error {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(v1.ModuleName, sdk.NewAttribute(v1.AttributeKeyDid, did)))
+	return ctx.EventManager().EmitTypedEvent(event)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateDidPublicKeyIndex updates the index of verification methods by public key when the DID Doc changes from oldDid to newDid.
+// oldDid is nil for new DID Docs.
+func (k Keeper) UpdateDidPublicKeyIndex(ctx sdk.Context, oldDid *v1.Did, newDid *v1.Did) {
+	if oldDid != nil {
+		for _, vm := range oldDid.VerificationMethod {
+			if fingerprint, err := vm.GetPublicKeyFingerprint(); err == nil {
+				k.didPublicKeyStore(ctx, fingerprint).Delete(GetDidIDBytes(vm.Id))
+			}
+		}
+	}
+
+	for _, vm := range newDid.VerificationMethod {
+		// Verification methods are validated on write, so only keys of unsupported types are skipped
+		if fingerprint, err := vm.GetPublicKeyFingerprint(); err == nil {
+			k.didPublicKeyStore(ctx, fingerprint).Set(GetDidIDBytes(vm.Id), GetDidIDBytes(newDid.Id))
+		}
+	}
+}
+
+// BackfillDidPublicKeyIndex indexes the verification methods of all stored DID Docs by public key.
+// Store migrations use it for DID Docs written before the index existed.
+func (k Keeper) BackfillDidPublicKeyIndex(ctx sdk.Context) error {
+	for _, state := range k.GetAllDid(ctx) {
+		did, err := state.GetDid()
+		if err != nil {
+			return err
+		}
+
+		k.UpdateDidPublicKeyIndex(ctx, nil, did)
+	}
+
+	return nil
+}
+
+// didPublicKeyStore returns the index of verification methods with the public key.
+// Multibase keys may contain any symbol, so the prefix is a fixed length digest of the key.
+func (k Keeper) didPublicKeyStore(ctx sdk.Context, fingerprint string) prefix.Store {
+	digest := sha256.Sum256([]byte(fingerprint))
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(v1.KeyPrefix(v1.DidPublicKeyKey), digest[:]...))
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"strconv"
+	"time"
+)
+
+// GetDidVersionCount get the total number of versions of a did
+func (k Keeper) GetDidVersionCount(ctx sdk.Context, id string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidVersionCountKey))
+	bz := store.Get(GetDidIDBytes(id))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to iint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetDidVersionCount set the total number of versions of a did
+func (k Keeper) SetDidVersionCount(ctx sdk.Context, id string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidVersionCountKey))
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(GetDidIDBytes(id), bz)
+}
+
+// AppendDidVersion appends a did version in the store and updates the version count
+func (k Keeper) AppendDidVersion(ctx sdk.Context, id string, stateValue *v1.StateValue) {
+	count := k.GetDidVersionCount(ctx, id)
+
+	store := k.didVersionStore(ctx, id)
+	b := k.cdc.MustMarshal(stateValue)
+	store.Set(sdk.Uint64ToBigEndian(count), b)
+
+	if stateValue.Metadata != nil {
+		idStore := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidVersionIdKey))
+		idStore.Set(GetDidVersionIDBytes(id, stateValue.Metadata.VersionId), sdk.Uint64ToBigEndian(count))
+	}
+
+	k.SetDidVersionCount(ctx, id, count+1)
+}
+
+// BackfillDidVersions records the current state of the DID Docs without a version history as their first version.
+// Store migrations use it for DID Docs written before the history existed.
+func (k Keeper) BackfillDidVersions(ctx sdk.Context) error {
+	for _, state := range k.GetAllDid(ctx) {
+		did, err := state.GetDid()
+		if err != nil {
+			return err
+		}
+
+		if k.GetDidVersionCount(ctx, did.Id) == 0 {
+			state := state
+			k.AppendDidVersion(ctx, did.Id, &state)
+		}
+	}
+
+	return nil
+}
+
+// HasDidVersion checks if the did version exists in the store
+func (k Keeper) HasDidVersion(ctx sdk.Context, id string, versionId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidVersionIdKey))
+	return store.Has(GetDidVersionIDBytes(id, versionId))
+}
+
+// GetDidVersion returns a did version from its id and version id
+func (k Keeper) GetDidVersion(ctx *sdk.Context, id string, versionId string) (*v1.StateValue, error) {
+	idStore := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidVersionIdKey))
+
+	if !k.HasDidVersion(*ctx, id, versionId) {
+		return nil, sdkerrors.ErrNotFound
+	}
+
+	var value v1.StateValue
+	var bytes = k.didVersionStore(*ctx, id).Get(idStore.Get(GetDidVersionIDBytes(id, versionId)))
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return &value, nil
+}
+
+// GetDidAtTime returns the did version that was actual at the specified time
+func (k Keeper) GetDidAtTime(ctx *sdk.Context, id string, at time.Time) (*v1.StateValue, error) {
+	iterator := sdk.KVStoreReversePrefixIterator(k.didVersionStore(*ctx, id), []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var value v1.StateValue
+		if err := k.cdc.Unmarshal(iterator.Value(), &value); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+		}
+
+		updated, err := value.Metadata.GetUpdatedTime()
+		if err != nil {
+			return nil, err
+		}
+
+		if !updated.After(at) {
+			return &value, nil
+		}
+	}
+
+	return nil, sdkerrors.ErrNotFound
+}
+
+// GetAllDidVersions returns all did versions ordered by did and creation
+func (k Keeper) GetAllDidVersions(ctx sdk.Context) (list []v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidVersionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val v1.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) didVersionStore(ctx sdk.Context, id string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(v1.KeyPrefix(v1.DidVersionKey), GetDidVersionPrefixBytes(id)...))
+}
+
+// GetDidVersionPrefixBytes returns the byte prefix of all versions of the did.
+// DIDs can't contain '/', so versions of one did never overlap with another's.
+func GetDidVersionPrefixBytes(id string) []byte {
+	return []byte(id + "/")
+}
+
+// GetDidVersionIDBytes returns the byte representation of the did version ID
+func GetDidVersionIDBytes(id string, versionId string) []byte {
+	return []byte(id + "/" + versionId)
+}
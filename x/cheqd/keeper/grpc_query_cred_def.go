@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) CredDef(c context.Context, req *v1.QueryGetCredDefRequest) (*v1.QueryGetCredDefResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, err := k.GetCredDef(&ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	credDef, err := state.GetCredDef()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetCredDefResponse{CredDef: credDef, Metadata: state.Metadata}, nil
+}
+
+func (k Keeper) CredDefByTag(c context.Context, req *v1.QueryGetCredDefByTagRequest) (*v1.QueryGetCredDefByTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tag := req.Tag
+	if len(tag) == 0 {
+		tag = v1.DefaultCredDefTag
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, err := k.GetCredDefByTag(&ctx, req.IssuerId, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	credDef, err := state.GetCredDef()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetCredDefByTagResponse{CredDef: credDef, Metadata: state.Metadata}, nil
+}
+
+func (k Keeper) AllCredDefs(c context.Context, req *v1.QueryAllCredDefsRequest) (*v1.QueryAllCredDefsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.CredDefKey))
+
+	var credDefs []*v1.CredDefWithMetadata
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var state v1.StateValue
+		if err := k.cdc.Unmarshal(value, &state); err != nil {
+			return err
+		}
+
+		credDef, err := state.GetCredDef()
+		if err != nil {
+			return err
+		}
+
+		credDefs = append(credDefs, &v1.CredDefWithMetadata{CredDef: credDef, Metadata: state.Metadata})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryAllCredDefsResponse{CredDefs: credDefs, Pagination: pageRes}, nil
+}
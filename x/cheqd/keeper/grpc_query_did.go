@@ -3,8 +3,11 @@ package keeper
 import (
 	"context"
 	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,3 +31,199 @@ func (k Keeper) Did(c context.Context, req *v1.QueryGetDidRequest) (*v1.QueryGet
 
 	return &v1.QueryGetDidResponse{Did: did, Metadata: state.Metadata}, nil
 }
+
+func (k Keeper) AllDids(c context.Context, req *v1.QueryAllDidsRequest) (*v1.QueryAllDidsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	createdAfter, err := parseOptionalTime(req.CreatedAfter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "created_after must be in RFC 3339 format")
+	}
+
+	createdBefore, err := parseOptionalTime(req.CreatedBefore)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "created_before must be in RFC 3339 format")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidKey))
+	if len(req.Namespace) > 0 {
+		store = prefix.NewStore(store, GetDidIDBytes(v1.DidPrefix+":"+v1.DidMethod+":"+req.Namespace+":"))
+	}
+
+	var dids []*v1.DidWithMetadata
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var state v1.StateValue
+		if err := k.cdc.Unmarshal(value, &state); err != nil {
+			return false, err
+		}
+
+		match, err := matchDidFilters(req, &state, createdAfter, createdBefore)
+		if err != nil || !match {
+			return false, err
+		}
+
+		if accumulate {
+			did, err := state.GetDid()
+			if err != nil {
+				return false, err
+			}
+
+			dids = append(dids, &v1.DidWithMetadata{Did: did, Metadata: state.Metadata})
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryAllDidsResponse{Dids: dids, Pagination: pageRes}, nil
+}
+
+func matchDidFilters(req *v1.QueryAllDidsRequest, state *v1.StateValue, createdAfter *time.Time, createdBefore *time.Time) (bool, error) {
+	switch req.Deactivated {
+	case v1.DeactivatedFilter_DEACTIVATED_FILTER_ACTIVE:
+		if state.Metadata.Deactivated {
+			return false, nil
+		}
+	case v1.DeactivatedFilter_DEACTIVATED_FILTER_DEACTIVATED:
+		if !state.Metadata.Deactivated {
+			return false, nil
+		}
+	}
+
+	if createdAfter == nil && createdBefore == nil {
+		return true, nil
+	}
+
+	created, err := state.Metadata.GetCreatedTime()
+	if err != nil {
+		return false, err
+	}
+
+	if createdAfter != nil && created.Before(*createdAfter) {
+		return false, nil
+	}
+
+	if createdBefore != nil && !created.Before(*createdBefore) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	result, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (k Keeper) DidsByController(c context.Context, req *v1.QueryGetDidsByControllerRequest) (*v1.QueryGetDidsByControllerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var dids []string
+	pageRes, err := query.Paginate(k.didControllerStore(ctx, req.Controller), req.Pagination, func(key []byte, value []byte) error {
+		dids = append(dids, string(value))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGetDidsByControllerResponse{Dids: dids, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DidsByPublicKey(c context.Context, req *v1.QueryGetDidsByPublicKeyRequest) (*v1.QueryGetDidsByPublicKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var verificationMethods []*v1.VerificationMethodReference
+	pageRes, err := query.Paginate(k.didPublicKeyStore(ctx, req.PublicKey), req.Pagination, func(key []byte, value []byte) error {
+		verificationMethods = append(verificationMethods, &v1.VerificationMethodReference{
+			Did:                  string(value),
+			VerificationMethodId: string(key),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGetDidsByPublicKeyResponse{VerificationMethods: verificationMethods, Pagination: pageRes}, nil
+}
+
+// MaxSimulatedSignatures is the maximum number of signatures verified by a SimulateDidUpdate query.
+// Queries are not metered, so the work per query has to be bounded.
+const MaxSimulatedSignatures = 128
+
+// SimulateDidUpdate runs the checks of UpdateDid against the current state and reports the required signers.
+// Nothing is written, so the supplied signatures may be incomplete.
+func (k Keeper) SimulateDidUpdate(c context.Context, req *v1.QuerySimulateDidUpdateRequest) (*v1.QuerySimulateDidUpdateResponse, error) {
+	if req == nil || req.Payload == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Signatures) > MaxSimulatedSignatures {
+		return nil, status.Errorf(codes.InvalidArgument, "too many signatures: %d > %d", len(req.Signatures), MaxSimulatedSignatures)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if maxSize := k.GetParams(ctx).MaxDidDocSize; uint64(req.Payload.Size()) > maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "payload is too large: %d > %d bytes", req.Payload.Size(), maxSize)
+	}
+	res := &v1.QuerySimulateDidUpdateResponse{
+		RequiredSigners: []string{},
+		Signatures:      []*v1.SignatureVerification{},
+	}
+
+	if _, _, err := k.ValidateDidUpdate(&ctx, req.Payload, req.Signatures); err != nil {
+		res.Error = err.Error()
+	}
+
+	var signers []v1.Signer
+	if state, err := k.GetDid(&ctx, req.Payload.Id); err == nil {
+		if oldDIDDoc, err := state.GetDid(); err == nil {
+			signers = v1.GetSignersOnDidUpdate(oldDIDDoc, req.Payload)
+		}
+	}
+
+	for _, signer := range signers {
+		res.RequiredSigners = append(res.RequiredSigners, signer.Signer)
+	}
+
+	signingInput := req.Payload.GetSignBytes()
+	for _, info := range req.Signatures {
+		verification := &v1.SignatureVerification{VerificationMethodId: info.VerificationMethodId, Valid: true}
+
+		if err := k.VerifySignInfo(&ctx, signingInput, signers, info); err != nil {
+			verification.Valid = false
+			verification.Error = err.Error()
+		}
+
+		res.Signatures = append(res.Signatures, verification)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidVersion(c context.Context, req *v1.QueryGetDidVersionRequest) (*v1.QueryGetDidVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, err := k.GetDidVersion(&ctx, req.Id, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	did, err := state.GetDid()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetDidVersionResponse{Did: did, Metadata: state.Metadata}, nil
+}
+
+func (k Keeper) DidAtTime(c context.Context, req *v1.QueryGetDidAtTimeRequest) (*v1.QueryGetDidAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	at, err := time.Parse(time.RFC3339, req.Timestamp)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "timestamp must be in RFC 3339 format")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, err := k.GetDidAtTime(&ctx, req.Id, at)
+	if err != nil {
+		return nil, err
+	}
+
+	did, err := state.GetDid()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetDidAtTimeResponse{Did: did, Metadata: state.Metadata}, nil
+}
+
+func (k Keeper) DidVersions(c context.Context, req *v1.QueryGetDidVersionsRequest) (*v1.QueryGetDidVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasDid(ctx, req.Id) {
+		return nil, v1.ErrDidDocNotFound.Wrap(req.Id)
+	}
+
+	var versions []*v1.Metadata
+	pageRes, err := query.Paginate(k.didVersionStore(ctx, req.Id), req.Pagination, func(key []byte, value []byte) error {
+		var state v1.StateValue
+		if err := k.cdc.Unmarshal(value, &state); err != nil {
+			return err
+		}
+
+		versions = append(versions, state.Metadata)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGetDidVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
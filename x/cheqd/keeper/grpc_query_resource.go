@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Resource(c context.Context, req *v1.QueryGetResourceRequest) (*v1.QueryGetResourceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	header, err := k.GetResourceHeader(&ctx, req.CollectionId, strings.ToLower(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetResourceResponse{Resource: header}, nil
+}
+
+func (k Keeper) ResourceData(c context.Context, req *v1.QueryGetResourceDataRequest) (*v1.QueryGetResourceDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	id := strings.ToLower(req.Id)
+
+	header, err := k.GetResourceHeader(&ctx, req.CollectionId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := k.GetResourceData(&ctx, req.CollectionId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetResourceDataResponse{MediaType: header.MediaType, Data: data}, nil
+}
+
+func (k Keeper) CollectionResources(c context.Context, req *v1.QueryGetCollectionResourcesRequest) (*v1.QueryGetCollectionResourcesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasDid(ctx, req.CollectionId) {
+		return nil, v1.ErrDidDocNotFound.Wrap(req.CollectionId)
+	}
+
+	var resources []*v1.ResourceHeader
+	pageRes, err := query.Paginate(k.resourceHeaderStore(ctx, req.CollectionId), req.Pagination, func(key []byte, value []byte) error {
+		var header v1.ResourceHeader
+		if err := k.cdc.Unmarshal(value, &header); err != nil {
+			return err
+		}
+
+		resources = append(resources, &header)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGetCollectionResourcesResponse{Resources: resources, Pagination: pageRes}, nil
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RevocRegDef(c context.Context, req *v1.QueryGetRevocRegDefRequest) (*v1.QueryGetRevocRegDefResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, err := k.GetRevocRegDef(&ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	revocRegDef, err := state.GetRevocRegDef()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetRevocRegDefResponse{RevocRegDef: revocRegDef, Metadata: state.Metadata}, nil
+}
+
+func (k Keeper) RevocRegAccum(c context.Context, req *v1.QueryGetRevocRegAccumRequest) (*v1.QueryGetRevocRegAccumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	at, err := parseOptionalTime(req.Timestamp)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "timestamp must be in RFC 3339 format")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasRevocRegDef(ctx, req.RevocRegDefId) {
+		return nil, v1.ErrResourceNotFound.Wrap(req.RevocRegDefId)
+	}
+
+	var state *v1.StateValue
+	if at == nil {
+		state, err = k.GetLatestRevocRegEntry(&ctx, req.RevocRegDefId)
+	} else {
+		state, err = k.GetRevocRegEntryAtTime(&ctx, req.RevocRegDefId, *at)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := state.GetRevocRegEntry()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetRevocRegAccumResponse{RevocRegEntry: entry, Metadata: state.Metadata}, nil
+}
+
+func (k Keeper) RevocRegDelta(c context.Context, req *v1.QueryGetRevocRegDeltaRequest) (*v1.QueryGetRevocRegDeltaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	from, err := parseOptionalTime(req.From)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "from must be in RFC 3339 format")
+	}
+
+	to, err := parseOptionalTime(req.To)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "to must be in RFC 3339 format")
+	}
+
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, status.Error(codes.InvalidArgument, "to must not be before from")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	defState, err := k.GetRevocRegDef(&ctx, req.RevocRegDefId)
+	if err != nil {
+		return nil, err
+	}
+
+	revocRegDef, err := defState.GetRevocRegDef()
+	if err != nil {
+		return nil, err
+	}
+
+	until := ctx.BlockTime()
+	if to != nil {
+		until = *to
+	}
+
+	states, err := k.GetRevocRegEntries(&ctx, req.RevocRegDefId, until)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata *v1.Metadata
+	var entries []*v1.RevocRegEntry
+	fromCount := 0
+
+	for _, state := range states {
+		entry, err := state.GetRevocRegEntry()
+		if err != nil {
+			return nil, err
+		}
+
+		if from != nil && !isCreatedAfter(state.Metadata, *from) {
+			fromCount++
+		}
+
+		entries = append(entries, entry)
+		metadata = state.Metadata
+	}
+
+	delta := v1.NewRevocRegDelta(revocRegDef, entries, fromCount)
+	return &v1.QueryGetRevocRegDeltaResponse{RevocRegDelta: delta, Metadata: metadata}, nil
+}
+
+func isCreatedAfter(metadata *v1.Metadata, at time.Time) bool {
+	created, err := metadata.GetCreatedTime()
+	return err == nil && created.After(at)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Schema(c context.Context, req *v1.QueryGetSchemaRequest) (*v1.QueryGetSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, err := k.GetSchema(&ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := state.GetSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGetSchemaResponse{Schema: schema, Metadata: state.Metadata}, nil
+}
+
+func (k Keeper) AllSchemas(c context.Context, req *v1.QueryAllSchemasRequest) (*v1.QueryAllSchemasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.SchemaKey))
+
+	var schemas []*v1.SchemaWithMetadata
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var state v1.StateValue
+		if err := k.cdc.Unmarshal(value, &state); err != nil {
+			return err
+		}
+
+		schema, err := state.GetSchema()
+		if err != nil {
+			return err
+		}
+
+		schemas = append(schemas, &v1.SchemaWithMetadata{Schema: schema, Metadata: state.Metadata})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryAllSchemasResponse{Schemas: schemas, Pagination: pageRes}, nil
+}
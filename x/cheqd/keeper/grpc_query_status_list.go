@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) StatusList(c context.Context, req *v1.QueryGetStatusListRequest) (*v1.QueryGetStatusListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, err := k.GetStatusList(&ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	statusList, err := state.GetStatusList()
+	if err != nil {
+		return nil, err
+	}
+
+	encodedList, err := statusList.Encode()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The bitstring is returned compressed only
+	statusList.Bitstring = nil
+
+	return &v1.QueryGetStatusListResponse{StatusList: statusList, EncodedList: encodedList, Metadata: state.Metadata}, nil
+}
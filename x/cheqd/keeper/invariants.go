@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all cheqd invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(v1.ModuleName, "did-count", DidCountInvariant(k))
+	ir.RegisterRoute(v1.ModuleName, "did-state-values", DidStateValuesInvariant(k))
+	ir.RegisterRoute(v1.ModuleName, "did-controllers", DidControllersInvariant(k))
+	ir.RegisterRoute(v1.ModuleName, "did-docs", DidDocsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the cheqd module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			DidCountInvariant(k),
+			DidStateValuesInvariant(k),
+			DidControllersInvariant(k),
+			DidDocsInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// DidCountInvariant checks that the DID count matches the number of stored DIDs
+func DidCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		count := uint64(0)
+		k.iterateDidStateValues(ctx, func(_ []byte, _ []byte) {
+			count++
+		})
+
+		stored := k.GetDidCount(ctx)
+		broken := stored != count
+
+		return sdk.FormatInvariant(
+			v1.ModuleName, "did-count",
+			fmt.Sprintf("\tstored DID count: %d\n\tDIDs in the store: %d\n", stored, count),
+		), broken
+	}
+}
+
+// DidStateValuesInvariant checks that every stored DID state value contains a DID Doc
+func DidStateValuesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken int
+
+		k.iterateDidStateValues(ctx, func(key []byte, bz []byte) {
+			if _, err := k.decodeDid(bz); err != nil {
+				broken++
+				msg += fmt.Sprintf("\t%s: %s\n", key, err.Error())
+			}
+		})
+
+		return sdk.FormatInvariant(
+			v1.ModuleName, "did-state-values",
+			fmt.Sprintf("%d DID state values can't be decoded\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// DidControllersInvariant checks that every controller and verification method controller
+// resolves to an existing DID with authentication keys
+func DidControllersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken int
+
+		k.iterateDids(ctx, func(did *v1.Did) {
+			if err := k.ValidateDidControllers(&ctx, did.Id, did.Controller, did.VerificationMethod); err != nil {
+				broken++
+				msg += fmt.Sprintf("\t%s: %s\n", did.Id, err.Error())
+			}
+		})
+
+		return sdk.FormatInvariant(
+			v1.ModuleName, "did-controllers",
+			fmt.Sprintf("%d DID Docs have unresolvable controllers\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// DidDocsInvariant checks that every stored DID Doc is well-formed for the current namespace.
+// The limits and types of the current params aren't checked, DID Docs written before a param change stay valid.
+func DidDocsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken int
+
+		prefix := k.GetDidPrefix(ctx)
+
+		k.iterateDids(ctx, func(did *v1.Did) {
+			payload := v1.NewMsgCreateDidPayloadPayload(
+				did.Context, did.Id, did.Controller, did.VerificationMethod, did.Authentication, did.AssertionMethod,
+				did.CapabilityInvocation, did.CapabilityDelegation, did.KeyAgreement, did.AlsoKnownAs, did.Service,
+			)
+
+			if err := payload.Validate(prefix, v1.PermissiveParams(did)); err != nil {
+				broken++
+				msg += fmt.Sprintf("\t%s: %s\n", did.Id, err.Error())
+			}
+		})
+
+		return sdk.FormatInvariant(
+			v1.ModuleName, "did-docs",
+			fmt.Sprintf("%d DID Docs are invalid\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// iterateDidStateValues iterates over the raw DID state values
+func (k Keeper) iterateDidStateValues(ctx sdk.Context, cb func(key []byte, bz []byte)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.DidKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Key(), iterator.Value())
+	}
+}
+
+// iterateDids iterates over the DID Docs which can be decoded.
+// Broken state values are reported by DidStateValuesInvariant.
+func (k Keeper) iterateDids(ctx sdk.Context, cb func(did *v1.Did)) {
+	k.iterateDidStateValues(ctx, func(_ []byte, bz []byte) {
+		if did, err := k.decodeDid(bz); err == nil {
+			cb(did)
+		}
+	})
+}
+
+func (k Keeper) decodeDid(bz []byte) (*v1.Did, error) {
+	var stateValue v1.StateValue
+	if err := k.cdc.Unmarshal(bz, &stateValue); err != nil {
+		return nil, err
+	}
+
+	if stateValue.Data == nil {
+		return nil, v1.ErrInvalidDidStateValue.Wrap("empty data")
+	}
+
+	return stateValue.GetDid()
+}
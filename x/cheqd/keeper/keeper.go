@@ -9,22 +9,34 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 type (
 	Keeper struct {
-		cdc      codec.Codec
-		storeKey sdk.StoreKey
+		cdc        codec.Codec
+		storeKey   sdk.StoreKey
+		paramSpace paramtypes.Subspace
 	}
 )
 
-func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace) *Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(v1.ParamKeyTable())
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
 	}
 }
 
+// StoreKey returns the key of the module store. It's used by store migrations.
+func (k Keeper) StoreKey() sdk.StoreKey {
+	return k.storeKey
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", v1.ModuleName))
 }
@@ -34,10 +46,19 @@ func (k Keeper) IsDidUsed(ctx sdk.Context, did string) bool {
 	return k.HasDid(ctx, did)
 }
 
+// EnsureDidIsNotUsed checks that no DIDDoc, schema or cred def uses the id, they share the DID namespace
 func (k Keeper) EnsureDidIsNotUsed(ctx sdk.Context, did string) error {
 	if k.HasDid(ctx, did) {
 		return sdkerrors.Wrap(v1.ErrDidDocExists, fmt.Sprintf("DID is already used by DIDDoc %s", did))
 	}
 
+	if k.HasSchema(ctx, did) {
+		return sdkerrors.Wrap(v1.ErrResourceExists, fmt.Sprintf("DID is already used by schema %s", did))
+	}
+
+	if k.HasCredDef(ctx, did) {
+		return sdkerrors.Wrap(v1.ErrResourceExists, fmt.Sprintf("DID is already used by cred def %s", did))
+	}
+
 	return nil
 }
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper keeper.Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper keeper.Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return MigrateV2(ctx, m.keeper)
+}
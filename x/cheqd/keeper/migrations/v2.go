@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LegacyDidNamespaceKey is the key the DID namespace was stored under in version 1.
+// The default namespace was used as the key by mistake instead of DidNamespaceKey.
+const LegacyDidNamespaceKey = v1.DidNamespace
+
+// MigrateV2 moves the DID namespace from the legacy key to DidNamespaceKey.
+// The DID Docs stored before version 2 get their version history and are indexed by controller and public key.
+func MigrateV2(ctx sdk.Context, k keeper.Keeper) error {
+	migrateDidNamespace(ctx, k)
+
+	if err := k.BackfillDidVersions(ctx); err != nil {
+		return err
+	}
+
+	if err := k.BackfillDidControllerIndex(ctx); err != nil {
+		return err
+	}
+
+	return k.BackfillDidPublicKeyIndex(ctx)
+}
+
+func migrateDidNamespace(ctx sdk.Context, k keeper.Keeper) {
+	store := prefix.NewStore(ctx.KVStore(k.StoreKey()), v1.KeyPrefix(LegacyDidNamespaceKey))
+	byteKey := v1.KeyPrefix(LegacyDidNamespaceKey)
+
+	bz := store.Get(byteKey)
+	if bz == nil {
+		return
+	}
+
+	k.SetDidNamespace(ctx, string(bz))
+	store.Delete(byteKey)
+}
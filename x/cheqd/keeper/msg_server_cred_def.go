@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateCredDef(goCtx context.Context, msg *v1.MsgCreateCredDef) (*v1.MsgCreateCredDefResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prefix := k.GetDidPrefix(ctx)
+
+	credDefMsg := msg.GetPayload()
+	if err := credDefMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+
+	tag := credDefMsg.GetTagOrDefault()
+
+	// Cred defs can't be updated, so the id and the issuer tags must be new
+	if k.HasCredDef(ctx, credDefMsg.Id) {
+		return nil, sdkerrors.Wrap(v1.ErrResourceExists, fmt.Sprintf("cred def %s already exists", credDefMsg.Id))
+	}
+
+	// Cred def ids share the DID namespace, so they can't take an id of a DID Doc or a schema
+	if err := k.EnsureDidIsNotUsed(ctx, credDefMsg.Id); err != nil {
+		return nil, err
+	}
+
+	for _, issuer := range credDefMsg.Controller {
+		if k.HasCredDefTag(ctx, issuer, tag) {
+			return nil, sdkerrors.Wrap(v1.ErrResourceExists, fmt.Sprintf("cred def with tag %s already exists for %s", tag, issuer))
+		}
+	}
+
+	if !k.HasSchema(ctx, credDefMsg.SchemaId) {
+		return nil, sdkerrors.Wrap(v1.ErrResourceNotFound, fmt.Sprintf("schema %s", credDefMsg.SchemaId))
+	}
+
+	// The controllers of the issuer DID Docs have to sign
+	signers, err := k.GetDidDocSigners(&ctx, credDefMsg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifySignature(&ctx, credDefMsg, signers, msg.GetSignatures()); err != nil {
+		return nil, err
+	}
+
+	var credDef = v1.CredDef{
+		Id:            credDefMsg.Id,
+		SchemaId:      credDefMsg.SchemaId,
+		SignatureType: credDefMsg.SignatureType,
+		Tag:           tag,
+		Value:         credDefMsg.Value,
+		Controller:    credDefMsg.Controller,
+	}
+
+	metadata := v1.NewMetadata(ctx)
+	if err := k.SetCredDef(ctx, credDef, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateCredDefResponse{
+		Id: credDef.Id,
+	}, nil
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
-	"github.com/cheqd/cheqd-node/x/cheqd/utils/strings"
-	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,7 +14,7 @@ func (k msgServer) CreateDid(goCtx context.Context, msg *v1.MsgCreateDid) (*v1.M
 	prefix := k.GetDidPrefix(ctx)
 
 	didMsg := msg.GetPayload()
-	if err := didMsg.Validate(prefix); err != nil {
+	if err := didMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
 		return nil, err
 	}
 
@@ -33,19 +31,7 @@ func (k msgServer) CreateDid(goCtx context.Context, msg *v1.MsgCreateDid) (*v1.M
 		return nil, err
 	}
 
-	var did = v1.Did{
-		Context:              didMsg.Context,
-		Id:                   didMsg.Id,
-		Controller:           didMsg.Controller,
-		VerificationMethod:   didMsg.VerificationMethod,
-		Authentication:       didMsg.Authentication,
-		AssertionMethod:      didMsg.AssertionMethod,
-		CapabilityInvocation: didMsg.CapabilityInvocation,
-		CapabilityDelegation: didMsg.CapabilityDelegation,
-		KeyAgreement:         didMsg.KeyAgreement,
-		AlsoKnownAs:          didMsg.AlsoKnownAs,
-		Service:              didMsg.Service,
-	}
+	did := didMsg.ToDid()
 
 	metadata := v1.NewMetadata(ctx)
 	id, err := k.AppendDid(ctx, did, &metadata)
@@ -53,6 +39,13 @@ func (k msgServer) CreateDid(goCtx context.Context, msg *v1.MsgCreateDid) (*v1.M
 		return nil, err
 	}
 
+	k.UpdateDidControllerIndex(ctx, nil, &did)
+	k.UpdateDidPublicKeyIndex(ctx, nil, &did)
+
+	if err := EmitDidEvent(ctx, did.Id, NewEventDidCreated(&did, &metadata)); err != nil {
+		return nil, err
+	}
+
 	return &v1.MsgCreateDidResponse{
 		Id: *id,
 	}, nil
@@ -60,138 +53,150 @@ func (k msgServer) CreateDid(goCtx context.Context, msg *v1.MsgCreateDid) (*v1.M
 
 func (k msgServer) UpdateDid(goCtx context.Context, msg *v1.MsgUpdateDid) (*v1.MsgUpdateDidResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	prefix := k.GetDidPrefix(ctx)
 
 	didMsg := msg.GetPayload()
-	if err := didMsg.Validate(prefix); err != nil {
+	oldStateValue, oldDIDDoc, err := k.ValidateDidUpdate(&ctx, didMsg, msg.Signatures)
+	if err != nil {
 		return nil, err
 	}
 
+	did := didMsg.ToDid()
+
+	metadata := v1.NewMetadata(ctx)
+	metadata.Created = oldStateValue.Metadata.Created
+	metadata.Deactivated = oldStateValue.Metadata.Deactivated
+
+	if err = k.SetDid(ctx, did, &metadata); err != nil {
+		return nil, err
+	}
+
+	k.UpdateDidControllerIndex(ctx, oldDIDDoc, &did)
+	k.UpdateDidPublicKeyIndex(ctx, oldDIDDoc, &did)
+
+	if err := EmitDidEvent(ctx, did.Id, NewEventDidUpdated(oldDIDDoc, oldStateValue.Metadata, &did, &metadata)); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateDidResponse{
+		Id: didMsg.Id,
+	}, nil
+}
+
+// ValidateDidUpdate runs every check of UpdateDid against the current state without writing.
+// It returns the current state of the DID Doc.
+func (k *Keeper) ValidateDidUpdate(ctx *sdk.Context, didMsg *v1.MsgUpdateDidPayload, signatures []*v1.SignInfo) (*v1.StateValue, *v1.Did, error) {
+	prefix := k.GetDidPrefix(*ctx)
+	if err := didMsg.Validate(prefix, k.GetParams(*ctx)); err != nil {
+		return nil, nil, err
+	}
+
 	// Checks that the did doesn't exist
-	if !k.HasDid(ctx, didMsg.Id) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %s doesn't exist", didMsg.Id))
+	if !k.HasDid(*ctx, didMsg.Id) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %s doesn't exist", didMsg.Id))
 	}
 
-	oldStateValue, err := k.GetDid(&ctx, didMsg.Id)
+	oldStateValue, err := k.GetDid(ctx, didMsg.Id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if oldStateValue.Metadata.Deactivated {
+		return nil, nil, sdkerrors.Wrap(v1.ErrDidDocDeactivated, didMsg.Id)
 	}
 
 	oldDIDDoc, err := oldStateValue.GetDid()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if err := k.ValidateDidControllers(&ctx, didMsg.Id, didMsg.Controller, didMsg.VerificationMethod); err != nil {
-		return nil, err
+	if err := k.ValidateDidControllers(ctx, didMsg.Id, didMsg.Controller, didMsg.VerificationMethod); err != nil {
+		return nil, nil, err
 	}
 
-	if err := k.VerifySignatureOnDidUpdate(&ctx, oldDIDDoc, didMsg, msg.Signatures); err != nil {
-		return nil, err
+	// DID Docs controlled by this one are signed with its authentication keys
+	if len(didMsg.Authentication) == 0 && k.ControlsOtherDids(*ctx, didMsg.Id) {
+		return nil, nil, v1.ErrBadRequest.Wrapf("%s controls other DID Docs, so it should keep authentication keys", didMsg.Id)
+	}
+
+	if err := k.VerifySignatureOnDidUpdate(ctx, oldDIDDoc, didMsg, signatures); err != nil {
+		return nil, nil, err
 	}
 
 	// replay protection
 	if oldStateValue.Metadata.VersionId != didMsg.VersionId {
 		errMsg := fmt.Sprintf("Ecpected %s with version %s. Got version %s", didMsg.Id, oldStateValue.Metadata.VersionId, didMsg.VersionId)
-		return nil, sdkerrors.Wrap(v1.ErrUnexpectedDidVersion, errMsg)
+		return nil, nil, sdkerrors.Wrap(v1.ErrUnexpectedDidVersion, errMsg)
 	}
 
-	var did = v1.Did{
-		Context:              didMsg.Context,
-		Id:                   didMsg.Id,
-		Controller:           didMsg.Controller,
-		VerificationMethod:   didMsg.VerificationMethod,
-		Authentication:       didMsg.Authentication,
-		AssertionMethod:      didMsg.AssertionMethod,
-		CapabilityInvocation: didMsg.CapabilityInvocation,
-		CapabilityDelegation: didMsg.CapabilityDelegation,
-		KeyAgreement:         didMsg.KeyAgreement,
-		AlsoKnownAs:          didMsg.AlsoKnownAs,
-		Service:              didMsg.Service,
-	}
+	return oldStateValue, oldDIDDoc, nil
+}
 
-	metadata := v1.NewMetadata(ctx)
-	metadata.Created = oldStateValue.Metadata.Created
-	metadata.Deactivated = oldStateValue.Metadata.Deactivated
+func (k msgServer) DeactivateDid(goCtx context.Context, msg *v1.MsgDeactivateDid) (*v1.MsgDeactivateDidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prefix := k.GetDidPrefix(ctx)
 
-	if err = k.SetDid(ctx, did, &metadata); err != nil {
+	didMsg := msg.GetPayload()
+	if err := didMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
 		return nil, err
 	}
 
-	return &v1.MsgUpdateDidResponse{
-		Id: didMsg.Id,
-	}, nil
-}
-
-func (k msgServer) VerifySignatureOnDidUpdate(ctx *sdk.Context, oldDIDDoc *v1.Did, newDIDDoc *v1.MsgUpdateDidPayload, signatures []*v1.SignInfo) error {
-	var signers = newDIDDoc.GetSigners()
+	// Checks that the did exists
+	if !k.HasDid(ctx, didMsg.Id) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %s doesn't exist", didMsg.Id))
+	}
 
-	// Get Old DID Doc controller if it's nil then assign self
-	oldController := oldDIDDoc.Controller
-	if len(oldController) == 0 {
-		oldController = []string{oldDIDDoc.Id}
+	oldStateValue, err := k.GetDid(&ctx, didMsg.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get New DID Doc controller if it's nil then assign self
-	newController := newDIDDoc.Controller
-	if len(newController) == 0 {
-		newController = []string{newDIDDoc.Id}
+	if oldStateValue.Metadata.Deactivated {
+		return nil, sdkerrors.Wrap(v1.ErrDidDocDeactivated, didMsg.Id)
 	}
 
-	// DID Doc controller has been changed
-	if removedControllers := strings.Complement(oldController, newController); len(removedControllers) > 0 {
-		for _, controller := range removedControllers {
-			signers = append(signers, v1.Signer{Signer: controller})
-		}
+	didDoc, err := oldStateValue.GetDid()
+	if err != nil {
+		return nil, err
 	}
 
-	for _, oldVM := range oldDIDDoc.VerificationMethod {
-		newVM := FindVerificationMethod(newDIDDoc.VerificationMethod, oldVM.Id)
+	if err := k.VerifySignature(&ctx, didMsg, didDoc.GetSigners(), msg.Signatures); err != nil {
+		return nil, err
+	}
 
-		// Verification Method has been deleted
-		if newVM == nil {
-			signers = AppendSignerIfNeed(signers, oldVM.Controller, newDIDDoc)
-			continue
-		}
+	// replay protection
+	if oldStateValue.Metadata.VersionId != didMsg.VersionId {
+		errMsg := fmt.Sprintf("Ecpected %s with version %s. Got version %s", didMsg.Id, oldStateValue.Metadata.VersionId, didMsg.VersionId)
+		return nil, sdkerrors.Wrap(v1.ErrUnexpectedDidVersion, errMsg)
+	}
 
-		// Verification Method has been changed
-		if !reflect.DeepEqual(oldVM, newVM) {
-			signers = AppendSignerIfNeed(signers, newVM.Controller, newDIDDoc)
-		}
+	metadata := v1.NewMetadata(ctx)
+	metadata.Created = oldStateValue.Metadata.Created
+	metadata.Deactivated = true
 
-		// Verification Method Controller has been changed, need to add old controller
-		if newVM.Controller != oldVM.Controller {
-			signers = AppendSignerIfNeed(signers, oldVM.Controller, newDIDDoc)
-		}
+	if err = k.SetDid(ctx, *didDoc, &metadata); err != nil {
+		return nil, err
 	}
 
-	if err := k.VerifySignature(ctx, newDIDDoc, signers, signatures); err != nil {
-		return err
+	if err := EmitDidEvent(ctx, didDoc.Id, NewEventDidDeactivated(didDoc, oldStateValue.Metadata, &metadata)); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &v1.MsgDeactivateDidResponse{
+		Id: didMsg.Id,
+	}, nil
 }
 
-func AppendSignerIfNeed(signers []v1.Signer, controller string, msg *v1.MsgUpdateDidPayload) []v1.Signer {
-	for _, signer := range signers {
-		if signer.Signer == controller {
-			return signers
-		}
-	}
+func (k *Keeper) VerifySignatureOnDidUpdate(ctx *sdk.Context, oldDIDDoc *v1.Did, newDIDDoc *v1.MsgUpdateDidPayload, signatures []*v1.SignInfo) error {
+	signers := v1.GetSignersOnDidUpdate(oldDIDDoc, newDIDDoc)
 
-	signer := v1.Signer{
-		Signer: controller,
-	}
-
-	if controller == msg.Id {
-		signer.VerificationMethod = msg.VerificationMethod
-		signer.Authentication = msg.Authentication
+	if err := k.VerifySignature(ctx, newDIDDoc, signers, signatures); err != nil {
+		return err
 	}
 
-	return append(signers, signer)
+	return nil
 }
 
-func (k msgServer) ValidateDidControllers(ctx *sdk.Context, id string, controllers []string, verMethods []*v1.VerificationMethod) error {
+func (k *Keeper) ValidateDidControllers(ctx *sdk.Context, id string, controllers []string, verMethods []*v1.VerificationMethod) error {
 
 	for _, verificationMethod := range verMethods {
 		if err := k.ValidateController(ctx, id, verificationMethod.Controller); err != nil {
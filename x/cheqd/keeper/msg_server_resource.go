@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateResource(goCtx context.Context, msg *v1.MsgCreateResource) (*v1.MsgCreateResourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prefix := k.GetDidPrefix(ctx)
+
+	resourceMsg := msg.GetPayload()
+	if err := resourceMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+
+	// UUIDs are case-insensitive, so one resource can't be stored under several ids
+	id := strings.ToLower(resourceMsg.Id)
+
+	if k.HasResource(ctx, resourceMsg.CollectionId, id) {
+		return nil, sdkerrors.Wrap(v1.ErrResourceExists, fmt.Sprintf("resource %s/%s already exists", resourceMsg.CollectionId, id))
+	}
+
+	// The controllers of the collection DID Doc have to sign
+	signers, err := k.GetDidDocSigners(&ctx, []string{resourceMsg.CollectionId})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifySignature(&ctx, resourceMsg, signers, msg.GetSignatures()); err != nil {
+		return nil, err
+	}
+
+	header := v1.ResourceHeader{
+		CollectionId: resourceMsg.CollectionId,
+		Id:           id,
+		Name:         resourceMsg.Name,
+		ResourceType: resourceMsg.ResourceType,
+		MediaType:    resourceMsg.MediaType,
+		Created:      ctx.BlockTime().Format(v1.MetadataTimeLayout),
+		Checksum:     v1.GetChecksum(resourceMsg.Data),
+	}
+
+	// A resource with the same name and type is a new version of it
+	previous, err := k.GetLatestResourceVersion(&ctx, header.CollectionId, header.Name, header.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != nil {
+		header.PreviousVersionId = previous.Id
+		previous.NextVersionId = header.Id
+		k.SetResourceHeader(ctx, previous)
+	}
+
+	k.SetResource(ctx, &v1.Resource{Header: &header, Data: resourceMsg.Data})
+
+	return &v1.MsgCreateResourceResponse{
+		Resource: &header,
+	}, nil
+}
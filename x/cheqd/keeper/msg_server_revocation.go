@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateRevocRegDef(goCtx context.Context, msg *v1.MsgCreateRevocRegDef) (*v1.MsgCreateRevocRegDefResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prefix := k.GetDidPrefix(ctx)
+
+	revocRegDefMsg := msg.GetPayload()
+	if err := revocRegDefMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+
+	if k.HasRevocRegDef(ctx, revocRegDefMsg.Id) {
+		return nil, sdkerrors.Wrap(v1.ErrResourceExists, fmt.Sprintf("revoc reg def %s already exists", revocRegDefMsg.Id))
+	}
+
+	// The issuers of the cred def have to sign
+	signers, err := k.getCredDefSigners(&ctx, revocRegDefMsg.CredDefId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifySignature(&ctx, revocRegDefMsg, signers, msg.GetSignatures()); err != nil {
+		return nil, err
+	}
+
+	var revocRegDef = v1.RevocRegDef{
+		Id:           revocRegDefMsg.Id,
+		CredDefId:    revocRegDefMsg.CredDefId,
+		RevocDefType: revocRegDefMsg.RevocDefType,
+		Tag:          revocRegDefMsg.Tag,
+		Value:        revocRegDefMsg.Value,
+	}
+
+	metadata := v1.NewMetadata(ctx)
+	if err := k.SetRevocRegDef(ctx, revocRegDef, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateRevocRegDefResponse{
+		Id: revocRegDef.Id,
+	}, nil
+}
+
+func (k msgServer) CreateRevocRegEntry(goCtx context.Context, msg *v1.MsgCreateRevocRegEntry) (*v1.MsgCreateRevocRegEntryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prefix := k.GetDidPrefix(ctx)
+
+	entryMsg := msg.GetPayload()
+	if err := entryMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+
+	state, err := k.GetRevocRegDef(&ctx, entryMsg.RevocRegDefId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(v1.ErrResourceNotFound, fmt.Sprintf("revoc reg def %s", entryMsg.RevocRegDefId))
+	}
+
+	revocRegDef, err := state.GetRevocRegDef()
+	if err != nil {
+		return nil, err
+	}
+
+	if entryMsg.RevocDefType != revocRegDef.RevocDefType {
+		return nil, v1.ErrBadRequest.Wrapf("RevocDefType should be %s", revocRegDef.RevocDefType)
+	}
+
+	for _, indices := range [][]uint64{entryMsg.Value.Issued, entryMsg.Value.Revoked} {
+		for _, index := range indices {
+			if index >= revocRegDef.Value.MaxCredNum {
+				return nil, v1.ErrBadRequest.Wrapf("Value index %d exceeds MaxCredNum %d", index, revocRegDef.Value.MaxCredNum)
+			}
+		}
+	}
+
+	// The issuers of the cred def have to sign
+	signers, err := k.getCredDefSigners(&ctx, revocRegDef.CredDefId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifySignature(&ctx, entryMsg, signers, msg.GetSignatures()); err != nil {
+		return nil, err
+	}
+
+	// replay protection
+	if latest, err := k.GetLatestRevocRegEntry(&ctx, revocRegDef.Id); err == nil {
+		latestEntry, err := latest.GetRevocRegEntry()
+		if err != nil {
+			return nil, err
+		}
+
+		if latestEntry.Value.Accum != entryMsg.Value.PrevAccum {
+			errMsg := fmt.Sprintf("Expected %s with previous accumulator %s. Got %s", revocRegDef.Id, latestEntry.Value.Accum, entryMsg.Value.PrevAccum)
+			return nil, sdkerrors.Wrap(v1.ErrUnexpectedPrevAccum, errMsg)
+		}
+	}
+
+	var entry = v1.RevocRegEntry{
+		RevocRegDefId: entryMsg.RevocRegDefId,
+		RevocDefType:  entryMsg.RevocDefType,
+		Value:         entryMsg.Value,
+	}
+
+	metadata := v1.NewMetadata(ctx)
+	if err := k.AppendRevocRegEntry(ctx, entry, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateRevocRegEntryResponse{
+		RevocRegDefId: entry.RevocRegDefId,
+	}, nil
+}
+
+func (k msgServer) getCredDefSigners(ctx *sdk.Context, credDefId string) ([]v1.Signer, error) {
+	state, err := k.GetCredDef(ctx, credDefId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(v1.ErrResourceNotFound, fmt.Sprintf("cred def %s", credDefId))
+	}
+
+	credDef, err := state.GetCredDef()
+	if err != nil {
+		return nil, err
+	}
+
+	return k.GetDidDocSigners(ctx, credDef.Controller)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateSchema(goCtx context.Context, msg *v1.MsgCreateSchema) (*v1.MsgCreateSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prefix := k.GetDidPrefix(ctx)
+
+	schemaMsg := msg.GetPayload()
+	if err := schemaMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+
+	// Schemas can't be updated, so the id must be new
+	if k.HasSchema(ctx, schemaMsg.Id) {
+		return nil, sdkerrors.Wrap(v1.ErrResourceExists, fmt.Sprintf("schema %s already exists", schemaMsg.Id))
+	}
+
+	// Schema ids share the DID namespace, so they can't take an id of a DID Doc
+	if err := k.EnsureDidIsNotUsed(ctx, schemaMsg.Id); err != nil {
+		return nil, err
+	}
+
+	// The controllers of the issuer DID Docs have to sign
+	signers, err := k.GetDidDocSigners(&ctx, schemaMsg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifySignature(&ctx, schemaMsg, signers, msg.GetSignatures()); err != nil {
+		return nil, err
+	}
+
+	var schema = v1.Schema{
+		Id:         schemaMsg.Id,
+		Type:       schemaMsg.Type,
+		AttrNames:  schemaMsg.AttrNames,
+		Name:       schemaMsg.Name,
+		Version:    schemaMsg.Version,
+		Controller: schemaMsg.Controller,
+	}
+
+	metadata := v1.NewMetadata(ctx)
+	if err := k.SetSchema(ctx, schema, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateSchemaResponse{
+		Id: schema.Id,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateStatusList(goCtx context.Context, msg *v1.MsgCreateStatusList) (*v1.MsgCreateStatusListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prefix := k.GetDidPrefix(ctx)
+
+	statusListMsg := msg.GetPayload()
+	if err := statusListMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+
+	if k.HasStatusList(ctx, statusListMsg.Id) {
+		return nil, sdkerrors.Wrap(v1.ErrResourceExists, fmt.Sprintf("status list %s already exists", statusListMsg.Id))
+	}
+
+	// The controllers of the owner DID Docs have to sign
+	signers, err := k.GetDidDocSigners(&ctx, statusListMsg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifySignature(&ctx, statusListMsg, signers, msg.GetSignatures()); err != nil {
+		return nil, err
+	}
+
+	statusList := v1.NewStatusList(statusListMsg.Id, statusListMsg.StatusPurpose, statusListMsg.Length, statusListMsg.Controller)
+
+	metadata := v1.NewMetadata(ctx)
+	if err := k.SetStatusList(ctx, statusList, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateStatusListResponse{
+		Id: statusList.Id,
+	}, nil
+}
+
+func (k msgServer) UpdateStatusList(goCtx context.Context, msg *v1.MsgUpdateStatusList) (*v1.MsgUpdateStatusListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prefix := k.GetDidPrefix(ctx)
+
+	updateMsg := msg.GetPayload()
+	if err := updateMsg.Validate(prefix, k.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+
+	// The bitstring pages with changed bits are read and written separately
+	oldStateValue, err := k.GetStatusListStateValue(&ctx, updateMsg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	statusList, err := oldStateValue.GetStatusList()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(updateMsg.Unset) > 0 && statusList.StatusPurpose == v1.StatusPurposeRevocation {
+		return nil, v1.ErrBadRequest.Wrap("Revoked credentials can't be reinstated")
+	}
+
+	for _, indices := range [][]uint64{updateMsg.Set, updateMsg.Unset} {
+		for _, index := range indices {
+			if index >= statusList.Length {
+				return nil, v1.ErrBadRequest.Wrapf("Index %d exceeds Length %d", index, statusList.Length)
+			}
+		}
+	}
+
+	// The controllers of the owner DID Docs have to sign
+	signers, err := k.GetDidDocSigners(&ctx, statusList.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifySignature(&ctx, updateMsg, signers, msg.GetSignatures()); err != nil {
+		return nil, err
+	}
+
+	// replay protection
+	if oldStateValue.Metadata.VersionId != updateMsg.VersionId {
+		errMsg := fmt.Sprintf("Expected %s with version %s. Got version %s", updateMsg.Id, oldStateValue.Metadata.VersionId, updateMsg.VersionId)
+		return nil, sdkerrors.Wrap(v1.ErrUnexpectedStatusListVersion, errMsg)
+	}
+
+	k.UpdateStatusListBits(ctx, statusList.Id, updateMsg.Set, updateMsg.Unset)
+
+	metadata := v1.NewMetadata(ctx)
+	metadata.Created = oldStateValue.Metadata.Created
+
+	stateValue, err := v1.NewStateValue(statusList, &metadata)
+	if err != nil {
+		return nil, v1.ErrSetToState.Wrap(err.Error())
+	}
+
+	k.SetStatusListStateValue(ctx, statusList.Id, stateValue)
+
+	return &v1.MsgUpdateStatusListResponse{
+		Id: statusList.Id,
+	}, nil
+}
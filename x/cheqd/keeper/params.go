@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the module params.
+// Params which haven't been set yet, e.g. before the first genesis with params, keep their default values.
+func (k Keeper) GetParams(ctx sdk.Context) v1.Params {
+	params := v1.DefaultParams()
+
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params v1.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetResource set a specific resource header and data in the store
+func (k Keeper) SetResource(ctx sdk.Context, resource *v1.Resource) {
+	k.SetResourceHeader(ctx, resource.Header)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.ResourceDataKey))
+	store.Set(GetResourceIDBytes(resource.Header.CollectionId, resource.Header.Id), resource.Data)
+}
+
+// SetResourceHeader set a specific resource header in the store.
+// A header without the next version becomes the latest version of its name and type.
+func (k Keeper) SetResourceHeader(ctx sdk.Context, header *v1.ResourceHeader) {
+	b := k.cdc.MustMarshal(header)
+	k.resourceHeaderStore(ctx, header.CollectionId).Set([]byte(header.Id), b)
+
+	if len(header.NextVersionId) == 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.ResourceLatestVersionKey))
+		store.Set(GetResourceLatestVersionBytes(header.CollectionId, header.Name, header.ResourceType), []byte(header.Id))
+	}
+}
+
+// GetResourceHeader returns a resource header from its collection and id
+func (k Keeper) GetResourceHeader(ctx *sdk.Context, collectionId string, id string) (*v1.ResourceHeader, error) {
+	if !k.HasResource(*ctx, collectionId, id) {
+		return nil, v1.ErrResourceNotFound.Wrapf("%s/%s", collectionId, id)
+	}
+
+	var value v1.ResourceHeader
+	var bytes = k.resourceHeaderStore(*ctx, collectionId).Get([]byte(id))
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return &value, nil
+}
+
+// GetResourceData returns the data of a resource from its collection and id
+func (k Keeper) GetResourceData(ctx *sdk.Context, collectionId string, id string) ([]byte, error) {
+	if !k.HasResource(*ctx, collectionId, id) {
+		return nil, v1.ErrResourceNotFound.Wrapf("%s/%s", collectionId, id)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.ResourceDataKey))
+	return store.Get(GetResourceIDBytes(collectionId, id)), nil
+}
+
+// HasResource checks if the resource exists in the store
+func (k Keeper) HasResource(ctx sdk.Context, collectionId string, id string) bool {
+	return k.resourceHeaderStore(ctx, collectionId).Has([]byte(id))
+}
+
+// GetLatestResourceVersion returns the last version of the resource with the name and type in the collection
+func (k Keeper) GetLatestResourceVersion(ctx *sdk.Context, collectionId string, name string, resourceType string) (*v1.ResourceHeader, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.ResourceLatestVersionKey))
+
+	id := store.Get(GetResourceLatestVersionBytes(collectionId, name, resourceType))
+	if id == nil {
+		return nil, nil
+	}
+
+	return k.GetResourceHeader(ctx, collectionId, string(id))
+}
+
+// GetAllResources returns all resources ordered by collection and id
+func (k Keeper) GetAllResources(ctx sdk.Context) (list []v1.Resource) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.ResourceHeaderKey))
+	dataStore := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.ResourceDataKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var header v1.ResourceHeader
+		k.cdc.MustUnmarshal(iterator.Value(), &header)
+		list = append(list, v1.Resource{
+			Header: &header,
+			Data:   dataStore.Get(iterator.Key()),
+		})
+	}
+
+	return
+}
+
+func (k Keeper) resourceHeaderStore(ctx sdk.Context, collectionId string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(v1.KeyPrefix(v1.ResourceHeaderKey), GetResourceIDBytes(collectionId, "")...))
+}
+
+// GetResourceIDBytes returns the byte representation of the collection and resource ID.
+// DIDs can't contain '/', so resources of one collection never overlap with another's.
+func GetResourceIDBytes(collectionId string, id string) []byte {
+	return []byte(collectionId + "/" + id)
+}
+
+// GetResourceLatestVersionBytes returns the byte representation of the collection ID, resource name and type.
+// The name is length-prefixed, so different names and types never overlap.
+func GetResourceLatestVersionBytes(collectionId string, name string, resourceType string) []byte {
+	key := append([]byte(collectionId+"/"), address.MustLengthPrefix([]byte(name))...)
+	return append(key, resourceType...)
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"strconv"
+	"time"
+)
+
+// SetRevocRegDef set a specific revoc reg def in the store
+func (k Keeper) SetRevocRegDef(ctx sdk.Context, revocRegDef v1.RevocRegDef, metadata *v1.Metadata) error {
+	stateValue, err := v1.NewStateValue(&revocRegDef, metadata)
+	if err != nil {
+		return v1.ErrSetToState.Wrap(err.Error())
+	}
+
+	k.SetRevocRegDefStateValue(ctx, revocRegDef.Id, stateValue)
+	return nil
+}
+
+// SetRevocRegDefStateValue set the state of a specific revoc reg def
+func (k Keeper) SetRevocRegDefStateValue(ctx sdk.Context, id string, stateValue *v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.RevocRegDefKey))
+	b := k.cdc.MustMarshal(stateValue)
+	store.Set(GetRevocRegDefIDBytes(id), b)
+}
+
+// GetRevocRegDef returns a revoc reg def from its id
+func (k Keeper) GetRevocRegDef(ctx *sdk.Context, id string) (*v1.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.RevocRegDefKey))
+
+	if !k.HasRevocRegDef(*ctx, id) {
+		return nil, v1.ErrResourceNotFound.Wrap(id)
+	}
+
+	var value v1.StateValue
+	var bytes = store.Get(GetRevocRegDefIDBytes(id))
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return &value, nil
+}
+
+// HasRevocRegDef checks if the revoc reg def exists in the store
+func (k Keeper) HasRevocRegDef(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.RevocRegDefKey))
+	return store.Has(GetRevocRegDefIDBytes(id))
+}
+
+// GetRevocRegDefIDBytes returns the byte representation of the ID
+func GetRevocRegDefIDBytes(id string) []byte {
+	return []byte(id)
+}
+
+// GetAllRevocRegDefs returns all revoc reg defs
+func (k Keeper) GetAllRevocRegDefs(ctx sdk.Context) (list []v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.RevocRegDefKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val v1.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRevocRegEntryCount get the total number of entries of a revoc reg def
+func (k Keeper) GetRevocRegEntryCount(ctx sdk.Context, id string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.RevocRegEntryCountKey))
+	bz := store.Get(GetRevocRegDefIDBytes(id))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to iint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetRevocRegEntryCount set the total number of entries of a revoc reg def
+func (k Keeper) SetRevocRegEntryCount(ctx sdk.Context, id string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.RevocRegEntryCountKey))
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(GetRevocRegDefIDBytes(id), bz)
+}
+
+// AppendRevocRegEntry appends a revoc reg entry in the store and updates the entry count
+func (k Keeper) AppendRevocRegEntry(ctx sdk.Context, revocRegEntry v1.RevocRegEntry, metadata *v1.Metadata) error {
+	stateValue, err := v1.NewStateValue(&revocRegEntry, metadata)
+	if err != nil {
+		return v1.ErrSetToState.Wrap(err.Error())
+	}
+
+	k.AppendRevocRegEntryStateValue(ctx, revocRegEntry.RevocRegDefId, stateValue)
+	return nil
+}
+
+// AppendRevocRegEntryStateValue appends the state of a revoc reg entry and updates the entry count
+func (k Keeper) AppendRevocRegEntryStateValue(ctx sdk.Context, id string, stateValue *v1.StateValue) {
+	count := k.GetRevocRegEntryCount(ctx, id)
+
+	b := k.cdc.MustMarshal(stateValue)
+	k.revocRegEntryStore(ctx, id).Set(sdk.Uint64ToBigEndian(count), b)
+
+	k.SetRevocRegEntryCount(ctx, id, count+1)
+}
+
+// GetLatestRevocRegEntry returns the last appended revoc reg entry
+func (k Keeper) GetLatestRevocRegEntry(ctx *sdk.Context, id string) (*v1.StateValue, error) {
+	count := k.GetRevocRegEntryCount(*ctx, id)
+	if count == 0 {
+		return nil, v1.ErrResourceNotFound.Wrapf("entry of %s", id)
+	}
+
+	var value v1.StateValue
+	var bytes = k.revocRegEntryStore(*ctx, id).Get(sdk.Uint64ToBigEndian(count - 1))
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return &value, nil
+}
+
+// GetRevocRegEntryAtTime returns the latest revoc reg entry created not after the specified time
+func (k Keeper) GetRevocRegEntryAtTime(ctx *sdk.Context, id string, at time.Time) (*v1.StateValue, error) {
+	iterator := sdk.KVStoreReversePrefixIterator(k.revocRegEntryStore(*ctx, id), []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var value v1.StateValue
+		if err := k.cdc.Unmarshal(iterator.Value(), &value); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+		}
+
+		created, err := value.Metadata.GetCreatedTime()
+		if err != nil {
+			return nil, err
+		}
+
+		if !created.After(at) {
+			return &value, nil
+		}
+	}
+
+	return nil, v1.ErrResourceNotFound.Wrapf("entry of %s", id)
+}
+
+// GetRevocRegEntries returns the revoc reg entries created not after the specified time ordered by creation
+func (k Keeper) GetRevocRegEntries(ctx *sdk.Context, id string, until time.Time) ([]v1.StateValue, error) {
+	iterator := sdk.KVStorePrefixIterator(k.revocRegEntryStore(*ctx, id), []byte{})
+
+	defer iterator.Close()
+
+	var list []v1.StateValue
+	for ; iterator.Valid(); iterator.Next() {
+		var value v1.StateValue
+		if err := k.cdc.Unmarshal(iterator.Value(), &value); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+		}
+
+		created, err := value.Metadata.GetCreatedTime()
+		if err != nil {
+			return nil, err
+		}
+
+		if created.After(until) {
+			break
+		}
+
+		list = append(list, value)
+	}
+
+	return list, nil
+}
+
+// GetAllRevocRegEntries returns all revoc reg entries ordered by revoc reg def and creation
+func (k Keeper) GetAllRevocRegEntries(ctx sdk.Context) (list []v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.RevocRegEntryKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val v1.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) revocRegEntryStore(ctx sdk.Context, id string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(v1.KeyPrefix(v1.RevocRegEntryKey), GetRevocRegEntryPrefixBytes(id)...))
+}
+
+// GetRevocRegEntryPrefixBytes returns the byte prefix of all entries of the revoc reg def.
+// Ids can't contain '/', so entries of one registry never overlap with another's.
+func GetRevocRegEntryPrefixBytes(id string) []byte {
+	return []byte(id + "/")
+}
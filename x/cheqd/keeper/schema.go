@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetSchema set a specific schema in the store
+func (k Keeper) SetSchema(ctx sdk.Context, schema v1.Schema, metadata *v1.Metadata) error {
+	stateValue, err := v1.NewStateValue(&schema, metadata)
+	if err != nil {
+		return v1.ErrSetToState.Wrap(err.Error())
+	}
+
+	k.SetSchemaStateValue(ctx, schema.Id, stateValue)
+	return nil
+}
+
+// SetSchemaStateValue set the state of a specific schema
+func (k Keeper) SetSchemaStateValue(ctx sdk.Context, id string, stateValue *v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.SchemaKey))
+	b := k.cdc.MustMarshal(stateValue)
+	store.Set(GetSchemaIDBytes(id), b)
+}
+
+// GetSchema returns a schema from its id
+func (k Keeper) GetSchema(ctx *sdk.Context, id string) (*v1.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.SchemaKey))
+
+	if !k.HasSchema(*ctx, id) {
+		return nil, v1.ErrResourceNotFound.Wrap(id)
+	}
+
+	var value v1.StateValue
+	var bytes = store.Get(GetSchemaIDBytes(id))
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return &value, nil
+}
+
+// HasSchema checks if the schema exists in the store
+func (k Keeper) HasSchema(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.SchemaKey))
+	return store.Has(GetSchemaIDBytes(id))
+}
+
+// GetSchemaIDBytes returns the byte representation of the ID
+func GetSchemaIDBytes(id string) []byte {
+	return []byte(id)
+}
+
+// GetAllSchemas returns all schemas
+func (k Keeper) GetAllSchemas(ctx sdk.Context) (list []v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.SchemaKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val v1.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetStatusList set a specific status list in the store.
+// The bitstring is stored in pages next to the status list, so updates rewrite only the changed pages.
+func (k Keeper) SetStatusList(ctx sdk.Context, statusList v1.StatusList, metadata *v1.Metadata) error {
+	bitstring := statusList.Bitstring
+	statusList.Bitstring = nil
+
+	stateValue, err := v1.NewStateValue(&statusList, metadata)
+	if err != nil {
+		return v1.ErrSetToState.Wrap(err.Error())
+	}
+
+	k.SetStatusListStateValue(ctx, statusList.Id, stateValue)
+
+	for start := 0; start < len(bitstring); start += v1.StatusListPageSize {
+		end := start + v1.StatusListPageSize
+		if end > len(bitstring) {
+			end = len(bitstring)
+		}
+
+		k.SetStatusListPage(ctx, statusList.Id, uint64(start/v1.StatusListPageSize), bitstring[start:end])
+	}
+
+	return nil
+}
+
+// SetStatusListStateValue set the state of a specific status list without its bitstring
+func (k Keeper) SetStatusListStateValue(ctx sdk.Context, id string, stateValue *v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.StatusListKey))
+	b := k.cdc.MustMarshal(stateValue)
+	store.Set(GetStatusListIDBytes(id), b)
+}
+
+// SetStatusListPage set a page of the status list bitstring
+func (k Keeper) SetStatusListPage(ctx sdk.Context, id string, page uint64, bitstring []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.StatusListPageKey))
+	store.Set(GetStatusListPageBytes(id, page), bitstring)
+}
+
+// GetStatusListPage returns a page of the status list bitstring
+func (k Keeper) GetStatusListPage(ctx sdk.Context, id string, page uint64) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.StatusListPageKey))
+	return store.Get(GetStatusListPageBytes(id, page))
+}
+
+// UpdateStatusListBits sets and unsets the bits of the status list and stores the changed pages
+func (k Keeper) UpdateStatusListBits(ctx sdk.Context, id string, set []uint64, unset []uint64) {
+	pages := make(map[uint64][]byte)
+	var changed []uint64
+
+	update := func(index uint64, value bool) {
+		page := index / v1.StatusListPageLength
+		if _, found := pages[page]; !found {
+			pages[page] = k.GetStatusListPage(ctx, id, page)
+			changed = append(changed, page)
+		}
+
+		v1.SetStatusListBit(pages[page], index%v1.StatusListPageLength, value)
+	}
+
+	for _, index := range set {
+		update(index, true)
+	}
+
+	for _, index := range unset {
+		update(index, false)
+	}
+
+	for _, page := range changed {
+		k.SetStatusListPage(ctx, id, page, pages[page])
+	}
+}
+
+// GetStatusList returns a status list with its bitstring from its id
+func (k Keeper) GetStatusList(ctx *sdk.Context, id string) (*v1.StateValue, error) {
+	state, err := k.GetStatusListStateValue(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.withStatusListBitstring(*ctx, state)
+}
+
+// GetStatusListStateValue returns a status list without its bitstring from its id
+func (k Keeper) GetStatusListStateValue(ctx *sdk.Context, id string) (*v1.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.StatusListKey))
+
+	if !k.HasStatusList(*ctx, id) {
+		return nil, v1.ErrResourceNotFound.Wrap(id)
+	}
+
+	var value v1.StateValue
+	var bytes = store.Get(GetStatusListIDBytes(id))
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return &value, nil
+}
+
+// HasStatusList checks if the status list exists in the store
+func (k Keeper) HasStatusList(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.StatusListKey))
+	return store.Has(GetStatusListIDBytes(id))
+}
+
+// GetStatusListIDBytes returns the byte representation of the ID
+func GetStatusListIDBytes(id string) []byte {
+	return []byte(id)
+}
+
+// GetStatusListPageBytes returns the byte representation of the ID and the page number
+func GetStatusListPageBytes(id string, page uint64) []byte {
+	return append([]byte(id+"/"), sdk.Uint64ToBigEndian(page)...)
+}
+
+// GetAllStatusLists returns all status lists with their bitstrings
+func (k Keeper) GetAllStatusLists(ctx sdk.Context) (list []v1.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), v1.KeyPrefix(v1.StatusListKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val v1.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		state, err := k.withStatusListBitstring(ctx, &val)
+		if err != nil {
+			panic(err)
+		}
+
+		list = append(list, *state)
+	}
+
+	return
+}
+
+// withStatusListBitstring returns the status list state value with the bitstring joined from its pages
+func (k Keeper) withStatusListBitstring(ctx sdk.Context, state *v1.StateValue) (*v1.StateValue, error) {
+	statusList, err := state.GetStatusList()
+	if err != nil {
+		return nil, err
+	}
+
+	bitstring := make([]byte, 0, (statusList.Length+7)/8)
+	for page := uint64(0); uint64(len(bitstring)) < (statusList.Length+7)/8; page++ {
+		bz := k.GetStatusListPage(ctx, statusList.Id, page)
+		if bz == nil {
+			return nil, v1.ErrInvalidDidStateValue.Wrapf("status list %s misses page %d", statusList.Id, page)
+		}
+
+		bitstring = append(bitstring, bz...)
+	}
+
+	statusList.Bitstring = bitstring
+
+	return v1.NewStateValue(statusList, state.Metadata)
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"crypto/ed25519"
 	"encoding/base64"
 	"fmt"
 	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
@@ -22,22 +21,12 @@ func (k *Keeper) VerifySignature(ctx *sdk.Context, msg v1.IdentityMsg, signers [
 	signingInput := msg.GetSignBytes()
 
 	for _, signer := range signers {
-		if signer.VerificationMethod == nil {
-			state, err := k.GetDid(ctx, signer.Signer)
-			if err != nil {
-				return v1.ErrDidDocNotFound.Wrap(signer.Signer)
-			}
-
-			didDoc, err := state.GetDid()
-			if err != nil {
-				return v1.ErrDidDocNotFound.Wrap(signer.Signer)
-			}
-
-			signer.Authentication = didDoc.Authentication
-			signer.VerificationMethod = didDoc.VerificationMethod
+		signer, err := k.loadSignerVerificationMethods(ctx, signer)
+		if err != nil {
+			return err
 		}
 
-		valid, err := VerifyIdentitySignature(signer, signatures, signingInput)
+		valid, err := v1.VerifyIdentitySignature(signer, signatures, signingInput)
 		if err != nil {
 			return sdkerrors.Wrap(v1.ErrInvalidSignature, err.Error())
 		}
@@ -50,6 +39,71 @@ func (k *Keeper) VerifySignature(ctx *sdk.Context, msg v1.IdentityMsg, signers [
 	return nil
 }
 
+// VerifySignInfo checks one signature against the authentication methods of the required signers
+func (k *Keeper) VerifySignInfo(ctx *sdk.Context, signingInput []byte, signers []v1.Signer, info *v1.SignInfo) error {
+	did, _ := utils.SplitDidUrlIntoDidAndFragment(info.VerificationMethodId)
+
+	for _, signer := range signers {
+		if signer.Signer != did {
+			continue
+		}
+
+		signer, err := k.loadSignerVerificationMethods(ctx, signer)
+		if err != nil {
+			return err
+		}
+
+		vm, err := v1.FindAuthenticationMethod(signer, info.VerificationMethodId)
+		if err != nil {
+			return err
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(info.Signature)
+		if err != nil {
+			return sdkerrors.Wrap(v1.ErrInvalidSignature, err.Error())
+		}
+
+		valid, err := vm.VerifySignature(signingInput, signature)
+		if err != nil {
+			return sdkerrors.Wrap(v1.ErrInvalidSignature, err.Error())
+		}
+
+		if !valid {
+			return sdkerrors.Wrap(v1.ErrInvalidSignature, info.VerificationMethodId)
+		}
+
+		return nil
+	}
+
+	return v1.ErrInvalidSignature.Wrapf("%s is not a required signer", did)
+}
+
+// loadSignerVerificationMethods fills the verification methods of a signer from its stored DID Doc
+func (k *Keeper) loadSignerVerificationMethods(ctx *sdk.Context, signer v1.Signer) (v1.Signer, error) {
+	if signer.VerificationMethod != nil {
+		return signer, nil
+	}
+
+	state, err := k.GetDid(ctx, signer.Signer)
+	if err != nil {
+		return signer, v1.ErrDidDocNotFound.Wrap(signer.Signer)
+	}
+
+	if state.Metadata.Deactivated {
+		return signer, v1.ErrDidDocDeactivated.Wrap(signer.Signer)
+	}
+
+	didDoc, err := state.GetDid()
+	if err != nil {
+		return signer, v1.ErrDidDocNotFound.Wrap(signer.Signer)
+	}
+
+	signer.Authentication = didDoc.Authentication
+	signer.VerificationMethod = didDoc.VerificationMethod
+
+	return signer, nil
+}
+
 func (k *Keeper) ValidateController(ctx *sdk.Context, id string, controller string) error {
 	if id == controller {
 		return nil
@@ -58,6 +112,9 @@ func (k *Keeper) ValidateController(ctx *sdk.Context, id string, controller stri
 	if err != nil {
 		return v1.ErrDidDocNotFound.Wrap(controller)
 	}
+	if state.Metadata.Deactivated {
+		return v1.ErrDidDocDeactivated.Wrap(controller)
+	}
 	didDoc, err := state.GetDid()
 	if err != nil {
 		return v1.ErrDidDocNotFound.Wrap(controller)
@@ -69,31 +126,41 @@ func (k *Keeper) ValidateController(ctx *sdk.Context, id string, controller stri
 	return nil
 }
 
-func VerifyIdentitySignature(signer v1.Signer, signatures []*v1.SignInfo, signingInput []byte) (bool, error) {
-	result := true
-	foundOne := false
+// GetDidDocSigners returns the signers that control the active DID Docs
+func (k *Keeper) GetDidDocSigners(ctx *sdk.Context, dids []string) ([]v1.Signer, error) {
+	var signers []v1.Signer
 
-	for _, info := range signatures {
-		did, _ := utils.SplitDidUrlIntoDidAndFragment(info.VerificationMethodId)
-		if did == signer.Signer {
-			pubKey, err := FindPublicKey(signer, info.VerificationMethodId)
-			if err != nil {
-				return false, err
-			}
+	for _, did := range dids {
+		state, err := k.GetDid(ctx, did)
+		if err != nil {
+			return nil, v1.ErrDidDocNotFound.Wrap(did)
+		}
 
-			signature, err := base64.StdEncoding.DecodeString(info.Signature)
-			if err != nil {
-				return false, err
-			}
+		if state.Metadata.Deactivated {
+			return nil, v1.ErrDidDocDeactivated.Wrap(did)
+		}
+
+		didDoc, err := state.GetDid()
+		if err != nil {
+			return nil, err
+		}
 
-			result = result && ed25519.Verify(pubKey, signingInput, signature)
-			foundOne = true
+		for _, signer := range didDoc.GetSigners() {
+			if !containsSigner(signers, signer.Signer) {
+				signers = append(signers, signer)
+			}
 		}
 	}
 
-	if !foundOne {
-		return false, fmt.Errorf("signature %s not found", signer.Signer)
+	return signers, nil
+}
+
+func containsSigner(signers []v1.Signer, did string) bool {
+	for _, signer := range signers {
+		if signer.Signer == did {
+			return true
+		}
 	}
 
-	return result, nil
+	return false
 }
@@ -0,0 +1,36 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// stateValueKeys are the prefixes of the keys storing StateValue
+var stateValueKeys = []string{
+	v1.DidKey, v1.DidVersionKey, v1.SchemaKey, v1.CredDefKey, v1.RevocRegDefKey, v1.RevocRegEntryKey, v1.StatusListKey,
+}
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding cheqd type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		for _, key := range stateValueKeys {
+			if !bytes.HasPrefix(kvA.Key, v1.KeyPrefix(key)) {
+				continue
+			}
+
+			var stateValueA, stateValueB v1.StateValue
+			cdc.MustUnmarshal(kvA.Value, &stateValueA)
+			cdc.MustUnmarshal(kvB.Value, &stateValueB)
+
+			return fmt.Sprintf("%v\n%v", stateValueA, stateValueB)
+		}
+
+		// Counts, indexes, namespace and resources
+		return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+	}
+}
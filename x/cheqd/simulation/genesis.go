@@ -0,0 +1,111 @@
+package simulation
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Simulation parameter constants
+const (
+	GenesisDidCount = "genesis_did_count"
+)
+
+// GenIdentityFees randomizes the identity fees. The fees are paid in the simulation bond denom.
+func GenIdentityFees(r *rand.Rand, denom string) []*v1.IdentityFee {
+	var fees []*v1.IdentityFee
+
+	for _, msg := range []sdk.Msg{&v1.MsgCreateDid{}, &v1.MsgUpdateDid{}} {
+		if r.Intn(2) == 0 {
+			continue
+		}
+
+		amount := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000)))
+		fees = append(fees, &v1.IdentityFee{MsgTypeUrl: sdk.MsgTypeURL(msg), Amount: []*sdk.Coin{{Denom: denom, Amount: amount}}})
+	}
+
+	return fees
+}
+
+// GenBurnFactor randomizes the burn factor
+func GenBurnFactor(r *rand.Rand) string {
+	return simtypes.RandomDecAmount(r, sdk.OneDec()).String()
+}
+
+// GenDids generates DIDs controlled by the keys derived from their verification method ids
+func GenDids(r *rand.Rand, prefix string, count int, metadata v1.Metadata) []*v1.StateValue {
+	dids := make([]*v1.StateValue, 0, count)
+
+	for i := 0; i < count; i++ {
+		id := prefix + simtypes.RandStringOfLength(r, 16)
+		vmId := id + "#key-1"
+
+		did := v1.Did{
+			Id:                 id,
+			VerificationMethod: []*v1.VerificationMethod{NewVerificationMethod(vmId, id, GenesisKey(vmId).Public().(ed25519.PublicKey))},
+			Authentication:     []string{vmId},
+		}
+
+		versionId := sha256.Sum256([]byte(id))
+		didMetadata := metadata
+		didMetadata.VersionId = base64.StdEncoding.EncodeToString(versionId[:])
+
+		stateValue, err := v1.NewStateValue(&did, &didMetadata)
+		if err != nil {
+			panic(err)
+		}
+
+		dids = append(dids, stateValue)
+	}
+
+	return dids
+}
+
+// RandomizedGenState generates a random GenesisState for the cheqd module
+func RandomizedGenState(simState *module.SimulationState) {
+	var identityFees []*v1.IdentityFee
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(v1.KeyIdentityFees), &identityFees, simState.Rand,
+		func(r *rand.Rand) { identityFees = GenIdentityFees(r, sdk.DefaultBondDenom) },
+	)
+
+	var burnFactor string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(v1.KeyBurnFactor), &burnFactor, simState.Rand,
+		func(r *rand.Rand) { burnFactor = GenBurnFactor(r) },
+	)
+
+	var didCount int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GenesisDidCount, &didCount, simState.Rand,
+		func(r *rand.Rand) { didCount = r.Intn(20) },
+	)
+
+	params := v1.DefaultParams()
+	params.IdentityFees = identityFees
+	params.BurnFactor = burnFactor
+
+	created := simState.GenTimestamp.UTC().String()
+	metadata := v1.Metadata{Created: created, Updated: created}
+
+	genesis := v1.DefaultGenesis()
+	genesis.Params = &params
+	genesis.DidList = GenDids(simState.Rand, keeper.NewDidPrefix(genesis.DidNamespace), didCount, metadata)
+
+	bz, err := json.MarshalIndent(&params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated cheqd parameters:\n%s\n", bz)
+
+	simState.GenState[v1.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,120 @@
+package simulation
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/multiformats/go-multibase"
+)
+
+// DidKeys keeps the private keys of the verification methods created during a simulation.
+// Keys of genesis DIDs are derived from the verification method ids, so they are known without being stored.
+type DidKeys struct {
+	keys map[string]ed25519.PrivateKey
+}
+
+func NewDidKeys() *DidKeys {
+	return &DidKeys{keys: map[string]ed25519.PrivateKey{}}
+}
+
+// GenesisKey returns the private key of a genesis DID verification method
+func GenesisKey(vmId string) ed25519.PrivateKey {
+	seed := sha256.Sum256([]byte(vmId))
+	return ed25519.NewKeyFromSeed(seed[:])
+}
+
+// Generate returns a new private key and remembers it
+func (dk *DidKeys) Generate(r *rand.Rand) ed25519.PrivateKey {
+	seed := make([]byte, ed25519.SeedSize)
+	r.Read(seed)
+
+	privKey := ed25519.NewKeyFromSeed(seed)
+	dk.keys[EncodePublicKey(privKey.Public().(ed25519.PublicKey))] = privKey
+
+	return privKey
+}
+
+// Get returns the private key of a verification method if it's known
+func (dk *DidKeys) Get(vm *v1.VerificationMethod) (ed25519.PrivateKey, bool) {
+	if privKey, ok := dk.keys[vm.PublicKeyMultibase]; ok {
+		return privKey, true
+	}
+
+	privKey := GenesisKey(vm.Id)
+	if EncodePublicKey(privKey.Public().(ed25519.PublicKey)) == vm.PublicKeyMultibase {
+		return privKey, true
+	}
+
+	return nil, false
+}
+
+// Sign signs the message with the known authentication keys of every signer.
+// It returns false if a signer has no known key.
+func (dk *DidKeys) Sign(ctx sdk.Context, k keeper.Keeper, msg v1.IdentityMsg, signers []v1.Signer) ([]*v1.SignInfo, bool) {
+	var signatures []*v1.SignInfo
+	signingInput := msg.GetSignBytes()
+
+	for _, signer := range signers {
+		if signer.VerificationMethod == nil {
+			state, err := k.GetDid(&ctx, signer.Signer)
+			if err != nil {
+				return nil, false
+			}
+
+			did, err := state.GetDid()
+			if err != nil {
+				return nil, false
+			}
+
+			signer.Authentication = did.Authentication
+			signer.VerificationMethod = did.VerificationMethod
+		}
+
+		signed := false
+
+		for _, authentication := range signer.Authentication {
+			vm := v1.FindVerificationMethod(signer.VerificationMethod, authentication)
+			if vm == nil {
+				continue
+			}
+
+			privKey, ok := dk.Get(vm)
+			if !ok {
+				continue
+			}
+
+			signatures = append(signatures, &v1.SignInfo{
+				VerificationMethodId: vm.Id,
+				Signature:            base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput)),
+			})
+			signed = true
+		}
+
+		if !signed {
+			return nil, false
+		}
+	}
+
+	return signatures, true
+}
+
+func NewVerificationMethod(id string, controller string, pubKey ed25519.PublicKey) *v1.VerificationMethod {
+	return &v1.VerificationMethod{
+		Id:                 id,
+		Type:               utils.Ed25519VerificationKey2020,
+		Controller:         controller,
+		PublicKeyMultibase: EncodePublicKey(pubKey),
+	}
+}
+
+func EncodePublicKey(pubKey ed25519.PublicKey) string {
+	// Base58 btc multibase encoding can't fail
+	encoded, _ := multibase.Encode(multibase.Base58BTC, pubKey)
+	return encoded
+}
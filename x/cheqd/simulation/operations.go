@@ -0,0 +1,407 @@
+package simulation
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/ante"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateDid                 = "op_weight_msg_create_did"
+	OpWeightMsgUpdateDidControllers      = "op_weight_msg_update_did_controllers"
+	OpWeightMsgRotateDidKey              = "op_weight_msg_rotate_did_key"
+	OpWeightMsgUpdateDidInvalidSignature = "op_weight_msg_update_did_invalid_signature"
+
+	DefaultWeightMsgCreateDid                 = 100
+	DefaultWeightMsgUpdateDidControllers      = 50
+	DefaultWeightMsgRotateDidKey              = 50
+	DefaultWeightMsgUpdateDidInvalidSignature = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak v1.AccountKeeper, bk v1.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateDid                 int
+		weightMsgUpdateDidControllers      int
+		weightMsgRotateDidKey              int
+		weightMsgUpdateDidInvalidSignature int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDid, &weightMsgCreateDid, nil,
+		func(_ *rand.Rand) { weightMsgCreateDid = DefaultWeightMsgCreateDid },
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateDidControllers, &weightMsgUpdateDidControllers, nil,
+		func(_ *rand.Rand) { weightMsgUpdateDidControllers = DefaultWeightMsgUpdateDidControllers },
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRotateDidKey, &weightMsgRotateDidKey, nil,
+		func(_ *rand.Rand) { weightMsgRotateDidKey = DefaultWeightMsgRotateDidKey },
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateDidInvalidSignature, &weightMsgUpdateDidInvalidSignature, nil,
+		func(_ *rand.Rand) { weightMsgUpdateDidInvalidSignature = DefaultWeightMsgUpdateDidInvalidSignature },
+	)
+
+	// The keys of the DIDs created during the simulation are shared by the operations
+	keys := NewDidKeys()
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateDid, SimulateMsgCreateDid(ak, bk, k, keys)),
+		simulation.NewWeightedOperation(weightMsgUpdateDidControllers, SimulateMsgUpdateDidControllers(ak, bk, k, keys)),
+		simulation.NewWeightedOperation(weightMsgRotateDidKey, SimulateMsgRotateDidKey(ak, bk, k, keys)),
+		simulation.NewWeightedOperation(weightMsgUpdateDidInvalidSignature, SimulateMsgUpdateDidInvalidSignature(ak, bk, k, keys)),
+	}
+}
+
+// SimulateMsgCreateDid generates a MsgCreateDid of a new DID. Some of the DIDs are controlled by existing ones.
+func SimulateMsgCreateDid(ak v1.AccountKeeper, bk v1.BankKeeper, k keeper.Keeper, keys *DidKeys) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&v1.MsgCreateDid{}).Type()
+
+		id := k.GetDidPrefix(ctx) + simtypes.RandStringOfLength(r, 16)
+		if k.HasDid(ctx, id) {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "DID already exists"), nil, nil
+		}
+
+		privKey := keys.Generate(r)
+		vm := NewVerificationMethod(id+"#key-1", id, privKey.Public().(ed25519.PublicKey))
+
+		payload := &v1.MsgCreateDidPayload{
+			Id:                 id,
+			VerificationMethod: []*v1.VerificationMethod{vm},
+			Authentication:     []string{vm.Id},
+		}
+
+		if r.Intn(3) == 0 {
+			if controller, ok := randomDid(r, ctx, k); ok {
+				payload.Controller = []string{id, controller.Id}
+			}
+		}
+
+		signatures, ok := keys.Sign(ctx, k, payload, payload.GetSigners())
+		if !ok {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "unknown controller keys"), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, k, accs, chainID, v1.NewMsgCreateDid(payload, signatures))
+	}
+}
+
+// SimulateMsgUpdateDidControllers generates a MsgUpdateDid replacing the controllers of a random DID
+func SimulateMsgUpdateDidControllers(ak v1.AccountKeeper, bk v1.BankKeeper, k keeper.Keeper, keys *DidKeys) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&v1.MsgUpdateDid{}).Type()
+
+		did, metadata, ok := randomActiveDid(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "no active DIDs"), nil, nil
+		}
+
+		payload := newUpdatePayload(did, metadata)
+		payload.Controller = []string{did.Id}
+
+		if controller, ok := randomDid(r, ctx, k); ok && controller.Id != did.Id {
+			switch r.Intn(3) {
+			case 0:
+				payload.Controller = []string{controller.Id}
+			case 1:
+				payload.Controller = append(payload.Controller, controller.Id)
+			}
+		}
+
+		signatures, ok := keys.Sign(ctx, k, payload, v1.GetSignersOnDidUpdate(did, payload))
+		if !ok {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "unknown controller keys"), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, k, accs, chainID, v1.NewMsgUpdateDid(payload, signatures))
+	}
+}
+
+// SimulateMsgRotateDidKey generates a MsgUpdateDid replacing the key of a random verification method
+func SimulateMsgRotateDidKey(ak v1.AccountKeeper, bk v1.BankKeeper, k keeper.Keeper, keys *DidKeys) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&v1.MsgUpdateDid{}).Type()
+
+		did, metadata, ok := randomActiveDid(r, ctx, k)
+		if !ok || len(did.VerificationMethod) == 0 {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "no active DIDs"), nil, nil
+		}
+
+		payload := newUpdatePayload(did, metadata)
+
+		i := r.Intn(len(payload.VerificationMethod))
+		oldVM := payload.VerificationMethod[i]
+		privKey := keys.Generate(r)
+		payload.VerificationMethod[i] = NewVerificationMethod(oldVM.Id, oldVM.Controller, privKey.Public().(ed25519.PublicKey))
+
+		signatures, ok := keys.Sign(ctx, k, payload, v1.GetSignersOnDidUpdate(did, payload))
+		if !ok {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "unknown controller keys"), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, k, accs, chainID, v1.NewMsgUpdateDid(payload, signatures))
+	}
+}
+
+// SimulateMsgUpdateDidInvalidSignature generates a MsgUpdateDid signed by unknown keys and checks it's rejected
+func SimulateMsgUpdateDidInvalidSignature(ak v1.AccountKeeper, bk v1.BankKeeper, k keeper.Keeper, keys *DidKeys) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&v1.MsgUpdateDid{}).Type()
+
+		did, metadata, ok := randomActiveDid(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "no active DIDs"), nil, nil
+		}
+
+		payload := newUpdatePayload(did, metadata)
+		payload.AlsoKnownAs = []string{simtypes.RandStringOfLength(r, 16)}
+
+		// The unknown keys pretend to be the keys of the required signers
+		forged := NewDidKeys()
+		signers := v1.GetSignersOnDidUpdate(did, payload)
+		for j, signer := range signers {
+			if signer.VerificationMethod == nil {
+				state, err := k.GetDid(&ctx, signer.Signer)
+				if err != nil {
+					return simtypes.NoOpMsg(v1.ModuleName, msgType, "signer not found"), nil, nil
+				}
+
+				signerDid, err := state.GetDid()
+				if err != nil {
+					return simtypes.NoOpMsg(v1.ModuleName, msgType, "signer not found"), nil, nil
+				}
+
+				signer.Authentication = signerDid.Authentication
+				signer.VerificationMethod = signerDid.VerificationMethod
+			}
+
+			var vms []*v1.VerificationMethod
+			for _, vm := range signer.VerificationMethod {
+				privKey := forged.Generate(r)
+				vms = append(vms, NewVerificationMethod(vm.Id, vm.Controller, privKey.Public().(ed25519.PublicKey)))
+			}
+
+			signers[j].Authentication = signer.Authentication
+			signers[j].VerificationMethod = vms
+		}
+
+		signatures, ok := forged.Sign(ctx, k, payload, signers)
+		if !ok {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "no authentication methods"), nil, nil
+		}
+
+		msg := v1.NewMsgUpdateDid(payload, signatures)
+
+		tx, opMsg, err := newTx(r, ctx, ak, bk, k, accs, chainID, msg)
+		if tx == nil {
+			return opMsg, nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err == nil {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "update with invalid signatures"), nil,
+				fmt.Errorf("update of %s with invalid signatures has been accepted", did.Id)
+		}
+
+		if !errors.Is(err, v1.ErrInvalidSignature) {
+			return simtypes.NoOpMsg(v1.ModuleName, msgType, "update with invalid signatures"), nil,
+				fmt.Errorf("update of %s with invalid signatures failed with an unexpected error: %w", did.Id, err)
+		}
+
+		return newOperationMsg(msg, false, "invalid signatures rejected"), nil, nil
+	}
+}
+
+// newUpdatePayload returns the payload updating the DID Doc without changes
+func newUpdatePayload(did *v1.Did, metadata *v1.Metadata) *v1.MsgUpdateDidPayload {
+	verificationMethods := make([]*v1.VerificationMethod, 0, len(did.VerificationMethod))
+	for _, vm := range did.VerificationMethod {
+		vm := *vm
+		verificationMethods = append(verificationMethods, &vm)
+	}
+
+	return &v1.MsgUpdateDidPayload{
+		Context:              did.Context,
+		Id:                   did.Id,
+		Controller:           did.Controller,
+		VerificationMethod:   verificationMethods,
+		Authentication:       did.Authentication,
+		AssertionMethod:      did.AssertionMethod,
+		CapabilityInvocation: did.CapabilityInvocation,
+		CapabilityDelegation: did.CapabilityDelegation,
+		KeyAgreement:         did.KeyAgreement,
+		AlsoKnownAs:          did.AlsoKnownAs,
+		Service:              did.Service,
+		VersionId:            metadata.VersionId,
+	}
+}
+
+// randomDid returns a random DID Doc of the state
+func randomDid(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*v1.Did, bool) {
+	dids := k.GetAllDid(ctx)
+	if len(dids) == 0 {
+		return nil, false
+	}
+
+	did, err := dids[r.Intn(len(dids))].GetDid()
+	if err != nil {
+		return nil, false
+	}
+
+	return did, true
+}
+
+// randomActiveDid returns a random DID Doc which hasn't been deactivated
+func randomActiveDid(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*v1.Did, *v1.Metadata, bool) {
+	var active []v1.StateValue
+	for _, state := range k.GetAllDid(ctx) {
+		if !state.Metadata.Deactivated {
+			active = append(active, state)
+		}
+	}
+
+	if len(active) == 0 {
+		return nil, nil, false
+	}
+
+	state := active[r.Intn(len(active))]
+
+	did, err := state.GetDid()
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return did, state.Metadata, true
+}
+
+// deliver sends the message paid by a random account
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak v1.AccountKeeper, bk v1.BankKeeper, k keeper.Keeper,
+	accs []simtypes.Account, chainID string, msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	tx, opMsg, err := newTx(r, ctx, ak, bk, k, accs, chainID, msg)
+	if tx == nil {
+		return opMsg, nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(v1.ModuleName, opMsg.Name, "unable to deliver tx"), nil, err
+	}
+
+	return opMsg, nil, nil
+}
+
+// newTx builds a transaction signed by a random account which pays the identity fee and random gas fees.
+// Cheqd messages have no account signers, so the account signs as the fee payer.
+// The transaction is nil if the account can't pay the identity fee.
+func newTx(
+	r *rand.Rand, ctx sdk.Context, ak v1.AccountKeeper, bk v1.BankKeeper, k keeper.Keeper,
+	accs []simtypes.Account, chainID string, msg sdk.Msg,
+) (sdk.Tx, simtypes.OperationMsg, error) {
+	msgType := msg.(legacytx.LegacyMsg).Type()
+
+	simAccount, _ := simtypes.RandomAcc(r, accs)
+	account := ak.GetAccount(ctx, simAccount.Address)
+
+	identityFee, err := ante.GetIdentityFee(k.GetParams(ctx), []sdk.Msg{msg})
+	if err != nil {
+		return nil, simtypes.NoOpMsg(v1.ModuleName, msgType, "unable to get identity fee"), err
+	}
+
+	spendable, hasNeg := bk.SpendableCoins(ctx, account.GetAddress()).SafeSub(identityFee)
+	if hasNeg {
+		return nil, simtypes.NoOpMsg(v1.ModuleName, msgType, "unable to pay identity fee"), nil
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return nil, simtypes.NoOpMsg(v1.ModuleName, msgType, "unable to generate fees"), err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	txBuilder := txGen.NewTxBuilder()
+
+	if err := txBuilder.SetMsgs(msg); err != nil {
+		return nil, simtypes.NoOpMsg(v1.ModuleName, msgType, "unable to set msgs"), err
+	}
+
+	txBuilder.SetFeeAmount(fees.Add(identityFee...))
+	txBuilder.SetGasLimit(helpers.DefaultGenTxGas)
+	txBuilder.(interface{ SetFeePayer(sdk.AccAddress) }).SetFeePayer(account.GetAddress())
+
+	if err := signTx(txGen, txBuilder, simAccount, account.GetAccountNumber(), account.GetSequence(), chainID); err != nil {
+		return nil, simtypes.NoOpMsg(v1.ModuleName, msgType, "unable to sign tx"), err
+	}
+
+	return txBuilder.GetTx(), newOperationMsg(msg, true, ""), nil
+}
+
+// newOperationMsg creates an operation message from the JSON of a cheqd message.
+// simtypes.NewOperationMsg can't be used as cheqd messages don't provide amino JSON sign bytes.
+func newOperationMsg(msg sdk.Msg, ok bool, comment string) simtypes.OperationMsg {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return simtypes.NewOperationMsgBasic(v1.RouterKey, msg.(legacytx.LegacyMsg).Type(), comment, ok, bz)
+}
+
+func signTx(txGen client.TxConfig, txBuilder client.TxBuilder, simAccount simtypes.Account, accNum, accSeq uint64, chainID string) error {
+	signMode := txGen.SignModeHandler().DefaultMode()
+
+	// The signer info has to be set before signing
+	err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   simAccount.PubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: accSeq,
+	})
+	if err != nil {
+		return err
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      accSeq,
+	}
+
+	signature, err := clienttx.SignWithPrivKey(signMode, signerData, txBuilder, simAccount.PrivKey, txGen, accSeq)
+	if err != nil {
+		return err
+	}
+
+	return txBuilder.SetSignatures(signature)
+}
@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(v1.ModuleName, string(v1.KeyIdentityFees),
+			func(r *rand.Rand) string {
+				bz, err := json.Marshal(GenIdentityFees(r, sdk.DefaultBondDenom))
+				if err != nil {
+					panic(err)
+				}
+
+				return string(bz)
+			},
+		),
+		simulation.NewSimParamChange(v1.ModuleName, string(v1.KeyBurnFactor),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenBurnFactor(r))
+			},
+		),
+	}
+}
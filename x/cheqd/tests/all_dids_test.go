@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllDids(t *testing.T) {
+	setup := Setup()
+	created := setup.Ctx.BlockTime()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+
+	setup.Ctx = setup.Ctx.WithBlockTime(created.Add(time.Hour)).WithTxBytes([]byte("bob"))
+	_, _, _ = setup.InitDid(BobDID)
+
+	setup.Ctx = setup.Ctx.WithBlockTime(created.Add(2 * time.Hour)).WithTxBytes([]byte("charlie"))
+	_, _, _ = setup.InitDid(CharlieDID)
+
+	_, err := setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: AliceDID}, aliceKeys)
+	require.Nil(t, err)
+
+	cases := []struct {
+		name     string
+		req      *v1.QueryAllDidsRequest
+		expected []string
+		errMsg   string
+	}{
+		{"All", &v1.QueryAllDidsRequest{}, []string{AliceDID, BobDID, CharlieDID}, ""},
+		{"Namespace", &v1.QueryAllDidsRequest{Namespace: "test"}, []string{AliceDID, BobDID, CharlieDID}, ""},
+		{"Unknown namespace", &v1.QueryAllDidsRequest{Namespace: "mainnet"}, nil, ""},
+		{"Active", &v1.QueryAllDidsRequest{Deactivated: v1.DeactivatedFilter_DEACTIVATED_FILTER_ACTIVE}, []string{BobDID, CharlieDID}, ""},
+		{"Deactivated", &v1.QueryAllDidsRequest{Deactivated: v1.DeactivatedFilter_DEACTIVATED_FILTER_DEACTIVATED}, []string{AliceDID}, ""},
+		{"Created after", &v1.QueryAllDidsRequest{CreatedAfter: created.Add(time.Hour).Format(time.RFC3339)}, []string{BobDID, CharlieDID}, ""},
+		{"Created before", &v1.QueryAllDidsRequest{CreatedBefore: created.Add(time.Hour).Format(time.RFC3339)}, []string{AliceDID}, ""},
+		{
+			"Created between",
+			&v1.QueryAllDidsRequest{
+				CreatedAfter:  created.Add(time.Minute).Format(time.RFC3339),
+				CreatedBefore: created.Add(2 * time.Hour).Format(time.RFC3339),
+			},
+			[]string{BobDID},
+			"",
+		},
+		{"Invalid time", &v1.QueryAllDidsRequest{CreatedAfter: "today"}, nil, "rpc error: code = InvalidArgument desc = created_after must be in RFC 3339 format"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := setup.Keeper.AllDids(sdk.WrapSDKContext(setup.Ctx), tc.req)
+
+			if tc.errMsg == "" {
+				require.Nil(t, err)
+
+				var actual []string
+				for _, did := range response.Dids {
+					actual = append(actual, did.Did.Id)
+				}
+				require.Equal(t, tc.expected, actual)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+
+	// Pagination with filters
+	response, err := setup.Keeper.AllDids(sdk.WrapSDKContext(setup.Ctx), &v1.QueryAllDidsRequest{
+		Deactivated: v1.DeactivatedFilter_DEACTIVATED_FILTER_ACTIVE,
+		Pagination:  &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(response.Dids))
+	require.Equal(t, BobDID, response.Dids[0].Did.Id)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+}
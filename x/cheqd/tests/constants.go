@@ -13,4 +13,9 @@ const (
 	CharlieKey1 = CharlieDID + "#key-1"
 	CharlieKey2 = CharlieDID + "#key-2"
 	CharlieKey3 = CharlieDID + "#key-3"
+
+	SchemaDID      = "did:cheqd:test:schema"
+	CredDefDID     = "did:cheqd:test:cred-def"
+	RevocRegDefDID = "did:cheqd:test:revoc-reg-def"
+	StatusListDID  = "did:cheqd:test:status-list"
 )
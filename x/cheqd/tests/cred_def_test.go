@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CreateCredDef(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	// Issuer DID Doc controlled by Alice only
+	issuerDID := "did:cheqd:test:issuer"
+	_, err := setup.SendCreateDid(&v1.MsgCreateDidPayload{Id: issuerDID, Controller: []string{AliceDID}}, aliceKeys)
+	require.Nil(t, err)
+
+	schemaMsg := v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{AliceDID})
+	_, err = setup.SendCreateSchema(schemaMsg, aliceKeys)
+	require.Nil(t, err)
+
+	value := &v1.CredDefValue{Primary: "{\"n\":\"1\"}"}
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *v1.MsgCreateCredDefPayload
+		keys   map[string]ed25519.PrivateKey
+		errMsg string
+	}{
+		{
+			valid:  false,
+			name:   "Unknown schema",
+			msg:    v1.NewMsgCreateCredDefPayload(CredDefDID, "did:cheqd:test:unknown", v1.CredDefSignatureType, "", value, []string{issuerDID}),
+			keys:   aliceKeys,
+			errMsg: "schema did:cheqd:test:unknown: resource not found",
+		},
+		{
+			valid:  false,
+			name:   "Unknown issuer",
+			msg:    v1.NewMsgCreateCredDefPayload(CredDefDID, SchemaDID, v1.CredDefSignatureType, "", value, []string{"did:cheqd:test:unknown"}),
+			keys:   aliceKeys,
+			errMsg: "did:cheqd:test:unknown: DID Doc not found",
+		},
+		{
+			valid:  false,
+			name:   "Signer doesn't control the issuer DID",
+			msg:    v1.NewMsgCreateCredDefPayload(CredDefDID, SchemaDID, v1.CredDefSignatureType, "", value, []string{issuerDID}),
+			keys:   bobKeys,
+			errMsg: "signature did:cheqd:test:alice not found: invalid signature detected",
+		},
+		{
+			valid:  false,
+			name:   "Cred def id is a DID",
+			msg:    v1.NewMsgCreateCredDefPayload(issuerDID, SchemaDID, v1.CredDefSignatureType, "", value, []string{issuerDID}),
+			keys:   aliceKeys,
+			errMsg: "DID is already used by DIDDoc did:cheqd:test:issuer: DID Doc exists",
+		},
+		{
+			valid:  false,
+			name:   "Cred def id is a schema id",
+			msg:    v1.NewMsgCreateCredDefPayload(SchemaDID, SchemaDID, v1.CredDefSignatureType, "", value, []string{issuerDID}),
+			keys:   aliceKeys,
+			errMsg: "DID is already used by schema did:cheqd:test:schema: resource exists",
+		},
+		{
+			valid: true,
+			name:  "Signed by the issuer DID controller",
+			msg:   v1.NewMsgCreateCredDefPayload(CredDefDID, SchemaDID, v1.CredDefSignatureType, "", value, []string{issuerDID}),
+			keys:  aliceKeys,
+		},
+		{
+			valid:  false,
+			name:   "Cred def already exists",
+			msg:    v1.NewMsgCreateCredDefPayload(CredDefDID, SchemaDID, v1.CredDefSignatureType, "other", value, []string{issuerDID}),
+			keys:   aliceKeys,
+			errMsg: "cred def did:cheqd:test:cred-def already exists: resource exists",
+		},
+		{
+			valid:  false,
+			name:   "Issuer tag already used",
+			msg:    v1.NewMsgCreateCredDefPayload("did:cheqd:test:cred-def-2", SchemaDID, v1.CredDefSignatureType, v1.DefaultCredDefTag, value, []string{issuerDID}),
+			keys:   aliceKeys,
+			errMsg: "cred def with tag tag already exists for did:cheqd:test:issuer: resource exists",
+		},
+		{
+			valid: true,
+			name:  "Another tag of the same issuer",
+			msg:   v1.NewMsgCreateCredDefPayload("did:cheqd:test:cred-def-2", SchemaDID, v1.CredDefSignatureType, "v2", value, []string{issuerDID}),
+			keys:  aliceKeys,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			credDef, err := setup.SendCreateCredDef(tc.msg, tc.keys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, tc.msg.Id, credDef.Id)
+				require.Equal(t, tc.msg.GetTagOrDefault(), credDef.Tag)
+				require.Equal(t, tc.msg.Value, credDef.Value)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestHandler_CreateDidWithCredDefId(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+
+	schemaMsg := v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{AliceDID})
+	_, err := setup.SendCreateSchema(schemaMsg, aliceKeys)
+	require.Nil(t, err)
+
+	value := &v1.CredDefValue{Primary: "{\"n\":\"1\"}"}
+	_, err = setup.SendCreateCredDef(v1.NewMsgCreateCredDefPayload(CredDefDID, SchemaDID, v1.CredDefSignatureType, "", value, []string{AliceDID}), aliceKeys)
+	require.Nil(t, err)
+
+	_, _, err = setup.InitDid(CredDefDID)
+	require.Error(t, err)
+	require.Equal(t, "DID is already used by cred def did:cheqd:test:cred-def: resource exists", err.Error())
+
+	_, err = setup.SendCreateSchema(v1.NewMsgCreateSchemaPayload(CredDefDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{AliceDID}), aliceKeys)
+	require.Error(t, err)
+	require.Equal(t, "DID is already used by cred def did:cheqd:test:cred-def: resource exists", err.Error())
+}
+
+func TestQueryCredDefs(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+
+	schemaMsg := v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{AliceDID})
+	_, err := setup.SendCreateSchema(schemaMsg, aliceKeys)
+	require.Nil(t, err)
+
+	value := &v1.CredDefValue{Primary: "{\"n\":\"1\"}", Revocation: "{\"g\":\"1\"}"}
+	_, err = setup.SendCreateCredDef(v1.NewMsgCreateCredDefPayload(CredDefDID, SchemaDID, v1.CredDefSignatureType, "", value, []string{AliceDID}), aliceKeys)
+	require.Nil(t, err)
+	_, err = setup.SendCreateCredDef(v1.NewMsgCreateCredDefPayload("did:cheqd:test:cred-def-2", SchemaDID, v1.CredDefSignatureType, "v2", value, []string{AliceDID}), aliceKeys)
+	require.Nil(t, err)
+
+	goCtx := sdk.WrapSDKContext(setup.Ctx)
+
+	credDefResponse, err := setup.Keeper.CredDef(goCtx, &v1.QueryGetCredDefRequest{Id: CredDefDID})
+	require.Nil(t, err)
+	require.Equal(t, SchemaDID, credDefResponse.CredDef.SchemaId)
+	require.Equal(t, value, credDefResponse.CredDef.Value)
+
+	byTagResponse, err := setup.Keeper.CredDefByTag(goCtx, &v1.QueryGetCredDefByTagRequest{IssuerId: AliceDID, Tag: "v2"})
+	require.Nil(t, err)
+	require.Equal(t, "did:cheqd:test:cred-def-2", byTagResponse.CredDef.Id)
+
+	// The default tag is used when the tag isn't set
+	byTagResponse, err = setup.Keeper.CredDefByTag(goCtx, &v1.QueryGetCredDefByTagRequest{IssuerId: AliceDID})
+	require.Nil(t, err)
+	require.Equal(t, CredDefDID, byTagResponse.CredDef.Id)
+
+	_, err = setup.Keeper.CredDefByTag(goCtx, &v1.QueryGetCredDefByTagRequest{IssuerId: BobDID, Tag: "v2"})
+	require.Error(t, err)
+	require.Equal(t, "did:cheqd:test:bob with tag v2: resource not found", err.Error())
+
+	allResponse, err := setup.Keeper.AllCredDefs(goCtx, &v1.QueryAllCredDefsRequest{})
+	require.Nil(t, err)
+	require.Len(t, allResponse.CredDefs, 2)
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"github.com/cheqd/cheqd-node/x/cheqd/client/rest"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDereferenceDidUrl(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	state, _ := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+
+	resourceMsg := v1.NewMsgCreateResourcePayload(AliceDID, ResourceID, "Logo", "Image", "image/png", []byte{1, 2, 3})
+	_, err := setup.SendCreateResource(resourceMsg, aliceKeys)
+	require.Nil(t, err)
+
+	queryClient := setup.QueryClient()
+
+	cases := []struct {
+		name        string
+		didUrl      string
+		status      int
+		error       string
+		contentType string
+		content     interface{}
+	}{
+		{"DID Doc", AliceDID, http.StatusOK, "", v1.DidLdJsonContentType, nil},
+		{"Verification method", AliceDID + "#key-1", http.StatusOK, "", v1.DidLdJsonContentType, nil},
+		{"Service by fragment", AliceDID + "#service-2", http.StatusOK, "", v1.DidLdJsonContentType, nil},
+		{"Service endpoint", AliceDID + "?service=service-2", http.StatusOK, "", v1.UriListContentType, "endpoint"},
+		{"Service endpoint with fragment", AliceDID + "?service=service-2#part", http.StatusOK, "", v1.UriListContentType, "endpoint#part"},
+		{"Version", AliceDID + "?versionId=" + state.Metadata.VersionId, http.StatusOK, "", v1.DidLdJsonContentType, nil},
+		{"Version time", AliceDID + "?versionTime=2021-01-01T00:00:00Z", http.StatusOK, "", v1.DidLdJsonContentType, nil},
+		{"Resource", AliceDID + "/resources/" + ResourceID, http.StatusOK, "", "image/png", []byte{1, 2, 3}},
+		{"Unknown fragment", AliceDID + "#key-2", http.StatusNotFound, v1.ResolutionNotFound, "", nil},
+		{"Unknown service", AliceDID + "?service=agent", http.StatusNotFound, v1.ResolutionNotFound, "", nil},
+		{"Unknown version", AliceDID + "?versionId=unknown", http.StatusNotFound, v1.ResolutionNotFound, "", nil},
+		{"Version time before creation", AliceDID + "?versionTime=2020-01-01T00:00:00Z", http.StatusNotFound, v1.ResolutionNotFound, "", nil},
+		{"Unknown resource", AliceDID + "/resources/" + NextResourceID, http.StatusNotFound, v1.ResolutionNotFound, "", nil},
+		{"Unknown path", AliceDID + "/keys", http.StatusNotFound, v1.ResolutionNotFound, "", nil},
+		{"Invalid version time", AliceDID + "?versionTime=yesterday", http.StatusBadRequest, v1.ResolutionInvalidDidUrl, "", nil},
+		{"Version id and time", AliceDID + "?versionId=1&versionTime=2021-01-01T00:00:00Z", http.StatusBadRequest, v1.ResolutionInvalidDidUrl, "", nil},
+		{"Invalid DID URL", "#key-1", http.StatusBadRequest, v1.ResolutionInvalidDidUrl, "", nil},
+		{"Other method", "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK#key-1", http.StatusNotImplemented, v1.ResolutionMethodNotSupported, "", nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, status := rest.DereferenceDidUrl(context.Background(), queryClient, tc.didUrl)
+
+			require.Equal(t, tc.status, status)
+			require.Equal(t, tc.error, result.DereferencingMetadata.Error)
+
+			if len(tc.error) > 0 {
+				require.Nil(t, result.ContentStream)
+				return
+			}
+
+			require.Equal(t, tc.contentType, result.DereferencingMetadata.ContentType)
+			require.NotNil(t, result.ContentMetadata)
+
+			if tc.content != nil {
+				require.Equal(t, tc.content, result.ContentStream)
+			}
+		})
+	}
+}
+
+func TestDereferenceResourceMetadata(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	resourceMsg := v1.NewMsgCreateResourcePayload(AliceDID, ResourceID, "Logo", "Image", "image/png", []byte{1, 2, 3})
+	_, err := setup.SendCreateResource(resourceMsg, aliceKeys)
+	require.Nil(t, err)
+
+	result, status := rest.DereferenceDidUrl(context.Background(), setup.QueryClient(), AliceDID+"/resources/"+ResourceID)
+	require.Equal(t, http.StatusOK, status)
+
+	// Created is XML datetime, as in DID Doc metadata
+	metadata := result.ContentMetadata.(*v1.ResourceMetadata)
+	require.Equal(t, "2021-01-01T00:00:00Z", metadata.Created)
+}
+
+func TestDereferenceDidUrlFragment(t *testing.T) {
+	setup := Setup()
+
+	_, _, _ = setup.InitDid(AliceDID)
+
+	result, _ := rest.DereferenceDidUrl(context.Background(), setup.QueryClient(), AliceDID+"#key-1")
+	vm, ok := result.ContentStream.(v1.DidDocVerificationMethod)
+
+	require.True(t, ok)
+	require.Equal(t, AliceDID+"#key-1", vm.Id)
+
+	result, _ = rest.DereferenceDidUrl(context.Background(), setup.QueryClient(), AliceDID+"#service-2")
+	service, ok := result.ContentStream.(v1.DidDocService)
+
+	require.True(t, ok)
+	require.Equal(t, "#service-2", service.Id)
+}
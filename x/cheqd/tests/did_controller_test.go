@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_DidsByController(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	controlled := "did:cheqd:test:controlled"
+	createMsg := &v1.MsgCreateDidPayload{
+		Id:         controlled,
+		Controller: []string{AliceDID},
+	}
+
+	_, err := setup.SendCreateDid(createMsg, aliceKeys)
+	require.Nil(t, err)
+
+	dids := queryDidsByController(t, setup, AliceDID)
+	require.ElementsMatch(t, []string{AliceDID, controlled}, dids)
+	require.Equal(t, []string{BobDID}, queryDidsByController(t, setup, BobDID))
+
+	// Move control from Alice to Bob
+	updateMsg := setup.CreateToUpdateDid(createMsg)
+	updateMsg.Controller = []string{BobDID}
+
+	keys := ConcatKeys(ConcatKeys(map[string]ed25519.PrivateKey{}, aliceKeys), bobKeys)
+	_, err = setup.SendUpdateDid(updateMsg, keys)
+	require.Nil(t, err)
+
+	require.Equal(t, []string{AliceDID}, queryDidsByController(t, setup, AliceDID))
+	require.ElementsMatch(t, []string{BobDID, controlled}, queryDidsByController(t, setup, BobDID))
+	require.Empty(t, queryDidsByController(t, setup, "did:cheqd:test:unknown"))
+}
+
+func TestHandler_ControllerKeepsAuthentication(t *testing.T) {
+	setup := Setup()
+
+	_, _, _ = setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+	charlieKeys, _, _ := setup.InitDid(CharlieDID)
+
+	controlled := "did:cheqd:test:controlled"
+	_, err := setup.SendCreateDid(&v1.MsgCreateDidPayload{Id: controlled, Controller: []string{BobDID}}, bobKeys)
+	require.Nil(t, err)
+
+	state, _ := setup.Keeper.GetDid(&setup.Ctx, BobDID)
+	bob, _ := state.GetDid()
+
+	// Bob controls another DID Doc, so he can't hand his control over to Charlie
+	updateMsg := setup.CreateToUpdateDid(&v1.MsgCreateDidPayload{Id: BobDID, Controller: []string{CharlieDID}, VerificationMethod: bob.VerificationMethod})
+	keys := ConcatKeys(ConcatKeys(map[string]ed25519.PrivateKey{}, bobKeys), charlieKeys)
+
+	_, err = setup.SendUpdateDid(updateMsg, keys)
+	require.EqualError(t, err, "did:cheqd:test:bob controls other DID Docs, so it should keep authentication keys: bad request")
+
+	// Charlie doesn't control other DID Docs
+	state, _ = setup.Keeper.GetDid(&setup.Ctx, CharlieDID)
+	charlie, _ := state.GetDid()
+
+	updateMsg = setup.CreateToUpdateDid(&v1.MsgCreateDidPayload{Id: CharlieDID, Controller: []string{BobDID}, VerificationMethod: charlie.VerificationMethod})
+	_, err = setup.SendUpdateDid(updateMsg, keys)
+	require.Nil(t, err)
+
+	res, broken := keeper.AllInvariants(setup.Keeper)(setup.Ctx)
+	require.False(t, broken, res)
+}
+
+func TestDidsByController_Pagination(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	for _, id := range []string{"did:cheqd:test:first", "did:cheqd:test:second"} {
+		_, err := setup.SendCreateDid(&v1.MsgCreateDidPayload{Id: id, Controller: []string{AliceDID}}, aliceKeys)
+		require.Nil(t, err)
+	}
+
+	res, err := setup.Keeper.DidsByController(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetDidsByControllerRequest{
+		Controller: AliceDID,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+
+	require.Nil(t, err)
+	require.Len(t, res.Dids, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+}
+
+func TestBackfillDidControllerIndex(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	controlled := "did:cheqd:test:controlled"
+	_, err := setup.SendCreateDid(&v1.MsgCreateDidPayload{Id: controlled, Controller: []string{AliceDID}}, aliceKeys)
+	require.Nil(t, err)
+
+	// DID Docs written before the index existed
+	setup.ClearStore(v1.DidControllerKey)
+	require.Empty(t, queryDidsByController(t, setup, AliceDID))
+
+	require.Nil(t, setup.Keeper.BackfillDidControllerIndex(setup.Ctx))
+	require.ElementsMatch(t, []string{AliceDID, controlled}, queryDidsByController(t, setup, AliceDID))
+}
+
+func queryDidsByController(t *testing.T, setup TestSetup, controller string) []string {
+	res, err := setup.Keeper.DidsByController(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetDidsByControllerRequest{Controller: controller})
+	require.Nil(t, err)
+
+	return res.Dids
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// parseDidEvents checks the untyped DID event and returns the typed one
+func parseDidEvents(t *testing.T, events []abci.Event, did string) proto.Message {
+	require.Len(t, events, 2)
+
+	require.Equal(t, v1.ModuleName, events[0].Type)
+	require.Equal(t, v1.AttributeKeyDid, string(events[0].Attributes[0].Key))
+	require.Equal(t, did, string(events[0].Attributes[0].Value))
+
+	event, err := sdk.ParseTypedEvent(events[1])
+	require.Nil(t, err)
+
+	return event
+}
+
+func TestDidEvents(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	aliceState, _ := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	// Create
+	keyPair := GenerateKeyPair()
+	createMsg := setup.CreateDid(keyPair.PublicKey, CharlieDID)
+	createMsg.Controller = []string{AliceDID}
+	createMsg.VerificationMethod[0].Controller = AliceDID
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("create"))
+	res, err := setup.Handler(setup.Ctx, setup.WrapCreateRequest(createMsg, aliceKeys))
+	require.Nil(t, err)
+
+	created, _ := setup.Keeper.GetDid(&setup.Ctx, createMsg.Id)
+	require.Equal(t, &v1.EventDidCreated{
+		Did:                 createMsg.Id,
+		VersionId:           created.Metadata.VersionId,
+		Controllers:         []string{AliceDID},
+		VerificationMethods: []string{createMsg.Id + "#key-1"},
+	}, parseDidEvents(t, res.Events, createMsg.Id))
+
+	// Update
+	updateMsg := setup.CreateToUpdateDid(createMsg)
+	updateMsg.VersionId = created.Metadata.VersionId
+	updateMsg.Controller = []string{BobDID}
+	updateMsg.VerificationMethod = []*v1.VerificationMethod{
+		{
+			Id:                 createMsg.Id + "#key-2",
+			Type:               "Ed25519VerificationKey2020",
+			Controller:         BobDID,
+			PublicKeyMultibase: createMsg.VerificationMethod[0].PublicKeyMultibase,
+		},
+	}
+	updateMsg.Authentication = []string{createMsg.Id + "#key-2"}
+	updateMsg.AssertionMethod = nil
+	updateMsg.CapabilityInvocation = nil
+	updateMsg.CapabilityDelegation = nil
+	updateMsg.KeyAgreement = nil
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("update"))
+	res, err = setup.Handler(setup.Ctx, setup.WrapUpdateRequest(updateMsg, ConcatKeys(ConcatKeys(map[string]ed25519.PrivateKey{}, aliceKeys), bobKeys)))
+	require.Nil(t, err)
+
+	updated, _ := setup.Keeper.GetDid(&setup.Ctx, createMsg.Id)
+	require.Equal(t, &v1.EventDidUpdated{
+		Did:                        createMsg.Id,
+		VersionId:                  updated.Metadata.VersionId,
+		PreviousVersionId:          created.Metadata.VersionId,
+		AddedControllers:           []string{BobDID},
+		RemovedControllers:         []string{AliceDID},
+		AddedVerificationMethods:   []string{createMsg.Id + "#key-2"},
+		ChangedVerificationMethods: []string{},
+		RemovedVerificationMethods: []string{createMsg.Id + "#key-1"},
+	}, parseDidEvents(t, res.Events, createMsg.Id))
+
+	// Deactivate
+	deactivateMsg := &v1.MsgDeactivateDidPayload{Id: AliceDID, VersionId: aliceState.Metadata.VersionId}
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("deactivate"))
+	res, err = setup.Handler(setup.Ctx, setup.WrapDeactivateRequest(deactivateMsg, aliceKeys))
+	require.Nil(t, err)
+
+	deactivated, _ := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.Equal(t, &v1.EventDidDeactivated{
+		Did:               AliceDID,
+		VersionId:         deactivated.Metadata.VersionId,
+		PreviousVersionId: aliceState.Metadata.VersionId,
+	}, parseDidEvents(t, res.Events, AliceDID))
+}
+
+func TestDidEvents_ChangedVerificationMethod(t *testing.T) {
+	vm := v1.VerificationMethod{
+		Id:                 AliceKey1,
+		Type:               "Ed25519VerificationKey2020",
+		Controller:         AliceDID,
+		PublicKeyMultibase: "z6MkqyTqE7fF2xzt7jvRDtMVjb5ZLxmmALeZM5gRBHsT3dBE4",
+	}
+	oldDid := &v1.Did{Id: AliceDID, VerificationMethod: []*v1.VerificationMethod{&vm}}
+
+	newVM := vm
+	newDid := &v1.Did{Id: AliceDID, VerificationMethod: []*v1.VerificationMethod{&newVM}}
+
+	event := keeper.NewEventDidUpdated(oldDid, &v1.Metadata{}, newDid, &v1.Metadata{})
+	require.Empty(t, event.AddedVerificationMethods)
+	require.Empty(t, event.ChangedVerificationMethods)
+	require.Empty(t, event.RemovedVerificationMethods)
+
+	newVM.PublicKeyMultibase = "z6MkjGpF8ZpDm9JcEo4tzHcU8Z2WLQ8nP9zzBZH6tLrxp9pD"
+
+	event = keeper.NewEventDidUpdated(oldDid, &v1.Metadata{}, newDid, &v1.Metadata{})
+	require.Equal(t, []string{AliceKey1}, event.ChangedVerificationMethods)
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_DidsByPublicKey(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, aliceMsg, _ := setup.InitDid(AliceDID)
+	aliceKey := aliceMsg.VerificationMethod[0].PublicKeyMultibase
+
+	require.Equal(t, []*v1.VerificationMethodReference{{Did: AliceDID, VerificationMethodId: AliceKey1}}, queryDidsByPublicKey(t, setup, aliceKey))
+
+	controlled := "did:cheqd:test:controlled"
+	oldKey, _, _ := ed25519.GenerateKey(rand.Reader)
+	newKey, _, _ := ed25519.GenerateKey(rand.Reader)
+
+	createMsg := &v1.MsgCreateDidPayload{
+		Id:         controlled,
+		Controller: []string{AliceDID},
+		VerificationMethod: []*v1.VerificationMethod{
+			{
+				Id:                 controlled + "#key-1",
+				Type:               "Ed25519VerificationKey2020",
+				Controller:         AliceDID,
+				PublicKeyMultibase: "z" + base58.Encode(oldKey),
+			},
+		},
+	}
+
+	_, err := setup.SendCreateDid(createMsg, aliceKeys)
+	require.Nil(t, err)
+
+	expected := []*v1.VerificationMethodReference{{Did: controlled, VerificationMethodId: controlled + "#key-1"}}
+	require.Equal(t, expected, queryDidsByPublicKey(t, setup, "z"+base58.Encode(oldKey)))
+
+	// Rotate the key
+	updateMsg := setup.CreateToUpdateDid(createMsg)
+	updateMsg.VerificationMethod = []*v1.VerificationMethod{
+		{
+			Id:                 controlled + "#key-2",
+			Type:               "Ed25519VerificationKey2020",
+			Controller:         AliceDID,
+			PublicKeyMultibase: "z" + base58.Encode(newKey),
+		},
+	}
+
+	_, err = setup.SendUpdateDid(updateMsg, aliceKeys)
+	require.Nil(t, err)
+
+	expected = []*v1.VerificationMethodReference{{Did: controlled, VerificationMethodId: controlled + "#key-2"}}
+	require.Empty(t, queryDidsByPublicKey(t, setup, "z"+base58.Encode(oldKey)))
+	require.Equal(t, expected, queryDidsByPublicKey(t, setup, "z"+base58.Encode(newKey)))
+}
+
+func TestHandler_DidsByJwkThumbprint(t *testing.T) {
+	setup := Setup()
+
+	pubKey, privKey, _ := ed25519.GenerateKey(rand.Reader)
+	jwk := []*v1.KeyValuePair{
+		{Key: "kty", Value: "OKP"},
+		{Key: "crv", Value: "Ed25519"},
+		{Key: "x", Value: base64.RawURLEncoding.EncodeToString(pubKey)},
+	}
+
+	msg := &v1.MsgCreateDidPayload{
+		Id: BobDID,
+		VerificationMethod: []*v1.VerificationMethod{
+			{
+				Id:           BobKey1,
+				Type:         "JsonWebKey2020",
+				Controller:   BobDID,
+				PublicKeyJwk: jwk,
+			},
+		},
+		Authentication: []string{BobKey1},
+	}
+
+	_, err := setup.SendCreateDid(msg, map[string]ed25519.PrivateKey{BobKey1: privKey})
+	require.Nil(t, err)
+
+	thumbprint, err := v1.GetJwkThumbprint(jwk)
+	require.Nil(t, err)
+	require.Equal(t, []*v1.VerificationMethodReference{{Did: BobDID, VerificationMethodId: BobKey1}}, queryDidsByPublicKey(t, setup, thumbprint))
+}
+
+func TestBackfillDidPublicKeyIndex(t *testing.T) {
+	setup := Setup()
+
+	_, aliceMsg, _ := setup.InitDid(AliceDID)
+	aliceKey := aliceMsg.VerificationMethod[0].PublicKeyMultibase
+
+	// DID Docs written before the index existed
+	setup.ClearStore(v1.DidPublicKeyKey)
+	require.Empty(t, queryDidsByPublicKey(t, setup, aliceKey))
+
+	require.Nil(t, setup.Keeper.BackfillDidPublicKeyIndex(setup.Ctx))
+	require.Equal(t, []*v1.VerificationMethodReference{{Did: AliceDID, VerificationMethodId: AliceKey1}}, queryDidsByPublicKey(t, setup, aliceKey))
+}
+
+func queryDidsByPublicKey(t *testing.T, setup TestSetup, publicKey string) []*v1.VerificationMethodReference {
+	res, err := setup.Keeper.DidsByPublicKey(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetDidsByPublicKeyRequest{PublicKey: publicKey})
+	require.Nil(t, err)
+
+	return res.VerificationMethods
+}
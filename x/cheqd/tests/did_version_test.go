@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDidVersionHistory(t *testing.T) {
+	setup := Setup()
+	created := setup.Ctx.BlockTime()
+
+	aliceKeys, aliceDid, _ := setup.InitDid(AliceDID)
+	firstVersion, _ := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+
+	// Rotate the key one hour later in another transaction
+	setup.Ctx = setup.Ctx.WithBlockTime(created.Add(time.Hour)).WithTxBytes([]byte("update"))
+	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.AlsoKnownAs = []string{"alice"}
+	_, err := setup.SendUpdateDid(updatedDidDoc, aliceKeys)
+	require.Nil(t, err)
+
+	secondVersion, _ := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NotEqual(t, firstVersion.Metadata.VersionId, secondVersion.Metadata.VersionId)
+
+	goCtx := sdk.WrapSDKContext(setup.Ctx)
+
+	// Resolve at version
+	versionResponse, err := setup.Keeper.DidVersion(goCtx, &v1.QueryGetDidVersionRequest{
+		Id:        AliceDID,
+		VersionId: firstVersion.Metadata.VersionId,
+	})
+	require.Nil(t, err)
+	require.Equal(t, firstVersion.Metadata, versionResponse.Metadata)
+	require.Equal(t, aliceDid.AlsoKnownAs, versionResponse.Did.AlsoKnownAs)
+
+	_, err = setup.Keeper.DidVersion(goCtx, &v1.QueryGetDidVersionRequest{Id: AliceDID, VersionId: "unknown"})
+	require.Error(t, err)
+
+	// Resolve at time
+	cases := []struct {
+		name      string
+		timestamp string
+		versionId string
+		errMsg    string
+	}{
+		{"Before creation", created.Add(-time.Second).Format(time.RFC3339), "", "not found"},
+		{"At creation", created.Format(time.RFC3339), firstVersion.Metadata.VersionId, ""},
+		{"Between versions", created.Add(time.Minute).Format(time.RFC3339), firstVersion.Metadata.VersionId, ""},
+		{"At update", created.Add(time.Hour).Format(time.RFC3339), secondVersion.Metadata.VersionId, ""},
+		{"After update", created.Add(2 * time.Hour).Format(time.RFC3339), secondVersion.Metadata.VersionId, ""},
+		{"Invalid timestamp", "yesterday", "", "rpc error: code = InvalidArgument desc = timestamp must be in RFC 3339 format"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := setup.Keeper.DidAtTime(goCtx, &v1.QueryGetDidAtTimeRequest{Id: AliceDID, Timestamp: tc.timestamp})
+
+			if tc.errMsg == "" {
+				require.Nil(t, err)
+				require.Equal(t, tc.versionId, response.Metadata.VersionId)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+
+	// List versions
+	versionsResponse, err := setup.Keeper.DidVersions(goCtx, &v1.QueryGetDidVersionsRequest{
+		Id:         AliceDID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), versionsResponse.Pagination.Total)
+	require.Equal(t, []*v1.Metadata{firstVersion.Metadata}, versionsResponse.Versions)
+
+	versionsResponse, err = setup.Keeper.DidVersions(goCtx, &v1.QueryGetDidVersionsRequest{
+		Id:         AliceDID,
+		Pagination: &query.PageRequest{Key: versionsResponse.Pagination.NextKey},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*v1.Metadata{secondVersion.Metadata}, versionsResponse.Versions)
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cheqd/cheqd-node/x/cheqd/client/rest"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type countingQueryClient struct {
+	v1.QueryClient
+	didCalls int
+}
+
+func (c *countingQueryClient) Did(ctx context.Context, in *v1.QueryGetDidRequest, opts ...grpc.CallOption) (*v1.QueryGetDidResponse, error) {
+	c.didCalls++
+	return c.QueryClient.Did(ctx, in, opts...)
+}
+
+type testResolverBackend struct {
+	height      int64
+	queryClient *countingQueryClient
+}
+
+func (b *testResolverBackend) LatestHeight(_ context.Context) (int64, error) {
+	return b.height, nil
+}
+
+func (b *testResolverBackend) QueryClient(_ int64) v1.QueryClient {
+	return b.queryClient
+}
+
+func initResolverDriver(setup *TestSetup) (*testResolverBackend, *mux.Router) {
+	backend := &testResolverBackend{height: 1, queryClient: &countingQueryClient{QueryClient: setup.QueryClient()}}
+
+	router := mux.NewRouter()
+	rest.NewResolverDriver(backend, 100).RegisterRoutes(router)
+
+	return backend, router
+}
+
+func TestResolverDriver_ContentNegotiation(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	_, err := setup.SendCreateResource(v1.NewMsgCreateResourcePayload(AliceDID, ResourceID, "Logo", "Image", "image/png", []byte{1, 2, 3}), aliceKeys)
+	require.Nil(t, err)
+
+	_, router := initResolverDriver(&setup)
+
+	cases := []struct {
+		name        string
+		path        string
+		accept      string
+		status      int
+		contentType string
+	}{
+		{"Default representation", "/1.0/identifiers/" + AliceDID, "", http.StatusOK, v1.DidResolutionContentType},
+		{"Resolution result", "/1.0/identifiers/" + AliceDID, "application/ld+json;profile=\"https://w3id.org/did-resolution\"", http.StatusOK, v1.DidResolutionContentType},
+		{"DID Doc JSON-LD", "/1.0/identifiers/" + AliceDID, "application/did+ld+json", http.StatusOK, v1.DidLdJsonContentType},
+		{"DID Doc JSON", "/1.0/identifiers/" + AliceDID, "text/html, application/did+json", http.StatusOK, v1.DidJsonContentType},
+		{"Unsupported representation", "/1.0/identifiers/" + AliceDID, "text/html", http.StatusNotAcceptable, v1.DidResolutionContentType},
+		{"Unknown DID", "/1.0/identifiers/did:cheqd:test:unknown", "application/did+ld+json", http.StatusNotFound, v1.DidResolutionContentType},
+		{"Resource data", "/1.0/identifiers/" + AliceDID + "/resources/" + ResourceID, "application/did+ld+json", http.StatusOK, "image/png"},
+		{"Service endpoint", "/1.0/identifiers/" + AliceDID + "%3Fservice=service-2", "application/did+ld+json", http.StatusSeeOther, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if len(tc.accept) > 0 {
+				request.Header.Set("Accept", tc.accept)
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			require.Equal(t, tc.status, recorder.Code)
+			if len(tc.contentType) > 0 {
+				require.Equal(t, tc.contentType, recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestResolverDriver_DidJson(t *testing.T) {
+	setup := Setup()
+
+	_, _, _ = setup.InitDid(AliceDID)
+	_, router := initResolverDriver(&setup)
+
+	request := httptest.NewRequest(http.MethodGet, "/1.0/identifiers/"+AliceDID, nil)
+	request.Header.Set("Accept", v1.DidJsonContentType)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	var didDoc map[string]interface{}
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &didDoc))
+	require.Equal(t, AliceDID, didDoc["id"])
+	require.NotContains(t, didDoc, "@context")
+}
+
+func TestResolverDriver_Cache(t *testing.T) {
+	setup := Setup()
+
+	_, _, _ = setup.InitDid(AliceDID)
+	backend := &testResolverBackend{height: 1, queryClient: &countingQueryClient{QueryClient: setup.QueryClient()}}
+	driver := rest.NewResolverDriver(backend, 100)
+
+	_, status := driver.Resolve(context.Background(), AliceDID)
+	require.Equal(t, http.StatusOK, status)
+
+	_, _ = driver.Resolve(context.Background(), AliceDID)
+	require.Equal(t, 1, backend.queryClient.didCalls)
+
+	// A new block invalidates the cache
+	backend.height = 2
+	_, _ = driver.Resolve(context.Background(), AliceDID)
+	require.Equal(t, 2, backend.queryClient.didCalls)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1053,3 +1054,87 @@ func TestHandler_DidDocAlreadyExists(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, "DID is already used by DIDDoc did:cheqd:test:alice: DID Doc exists", err.Error())
 }
+
+func TestHandler_DeactivateDid(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, aliceDid, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	// Bob is not a controller of Alice's DID Doc
+	_, err := setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: AliceDID}, bobKeys)
+	require.Error(t, err)
+	require.Equal(t, "signature did:cheqd:test:alice not found: invalid signature detected", err.Error())
+
+	// Wrong version
+	_, err = setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: AliceDID, VersionId: "wrong"}, aliceKeys)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected DID version")
+
+	state, err := setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: AliceDID}, aliceKeys)
+	require.Nil(t, err)
+	require.True(t, state.Metadata.Deactivated)
+
+	// Deactivated DID Doc is still resolvable
+	response, err := setup.Keeper.Did(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetDidRequest{Id: AliceDID})
+	require.Nil(t, err)
+	require.Equal(t, AliceDID, response.Did.Id)
+	require.True(t, response.Metadata.Deactivated)
+
+	// Deactivated DID Doc can't be updated
+	_, err = setup.SendUpdateDid(setup.CreateToUpdateDid(aliceDid), aliceKeys)
+	require.Error(t, err)
+	require.Equal(t, "did:cheqd:test:alice: DID Doc deactivated", err.Error())
+
+	// Deactivated DID Doc can't be deactivated twice
+	_, err = setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: AliceDID}, aliceKeys)
+	require.Error(t, err)
+	require.Equal(t, "did:cheqd:test:alice: DID Doc deactivated", err.Error())
+}
+
+func TestHandler_DeactivatedController(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+
+	controlled := "did:cheqd:test:controlled"
+	createMsg := &v1.MsgCreateDidPayload{Id: controlled, Controller: []string{AliceDID}}
+	_, err := setup.SendCreateDid(createMsg, aliceKeys)
+	require.Nil(t, err)
+
+	_, err = setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: AliceDID}, aliceKeys)
+	require.Nil(t, err)
+
+	// Deactivated controller can't sign for the DID Docs it controls
+	updateMsg := setup.CreateToUpdateDid(createMsg)
+	updateMsg.AlsoKnownAs = []string{"did:cheqd:test:other"}
+	_, err = setup.SendUpdateDid(updateMsg, aliceKeys)
+	require.Error(t, err)
+	require.Equal(t, "did:cheqd:test:alice: DID Doc deactivated", err.Error())
+
+	_, err = setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: controlled}, aliceKeys)
+	require.Error(t, err)
+	require.Equal(t, "did:cheqd:test:alice: DID Doc deactivated", err.Error())
+
+	// Deactivated DID can't become a controller
+	_, err = setup.SendCreateDid(&v1.MsgCreateDidPayload{Id: "did:cheqd:test:new", Controller: []string{AliceDID}}, aliceKeys)
+	require.Error(t, err)
+	require.Equal(t, "did:cheqd:test:alice: DID Doc deactivated", err.Error())
+}
+
+func TestHandler_DeactivateDidByController(t *testing.T) {
+	setup := Setup()
+	keys := setup.CreatePreparedDID()
+
+	// Charlie's DID Doc is controlled by itself, all its verification methods belong to Bob
+	_, err := setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: CharlieDID}, map[string]ed25519.PrivateKey{
+		CharlieKey1: keys[CharlieKey1].PrivateKey,
+	})
+	require.Nil(t, err)
+
+	_, err = setup.SendDeactivateDid(&v1.MsgDeactivateDidPayload{Id: "did:cheqd:test:unknown", VersionId: "1"}, map[string]ed25519.PrivateKey{
+		CharlieKey1: keys[CharlieKey1].PrivateKey,
+	})
+	require.Error(t, err)
+	require.Equal(t, "key did:cheqd:test:unknown doesn't exist: key not found", err.Error())
+}
@@ -7,11 +7,17 @@ import (
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/cheqd/cheqd-node/x/cheqd"
 	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"strings"
 	"time"
 
 	"github.com/cheqd/cheqd-node/app/params"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -26,10 +32,11 @@ type KeyPair struct {
 }
 
 type TestSetup struct {
-	Cdc     codec.Codec
-	Ctx     sdk.Context
-	Keeper  keeper.Keeper
-	Handler sdk.Handler
+	Cdc          codec.Codec
+	Ctx          sdk.Context
+	Keeper       keeper.Keeper
+	ParamsKeeper paramskeeper.Keeper
+	Handler      sdk.Handler
 }
 
 func Setup() TestSetup {
@@ -44,10 +51,17 @@ func Setup() TestSetup {
 	storeKey := sdk.NewKVStoreKey(v1.StoreKey)
 	dbStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
 
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	dbStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, nil)
+
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	dbStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
-	newKeeper := keeper.NewKeeper(cdc, storeKey)
+	paramsKeeper := paramskeeper.NewKeeper(cdc, encodingConfig.Amino, paramsStoreKey, paramsTStoreKey)
+	newKeeper := keeper.NewKeeper(cdc, storeKey, paramsKeeper.Subspace(v1.ModuleName))
 
 	// Create Tx
 	txBytes := make([]byte, 28)
@@ -62,16 +76,24 @@ func Setup() TestSetup {
 	handler := cheqd.NewHandler(*newKeeper)
 
 	setup := TestSetup{
-		Cdc:     cdc,
-		Ctx:     ctx,
-		Keeper:  *newKeeper,
-		Handler: handler,
+		Cdc:          cdc,
+		Ctx:          ctx,
+		Keeper:       *newKeeper,
+		ParamsKeeper: paramsKeeper,
+		Handler:      handler,
 	}
 
 	setup.Keeper.SetDidNamespace(ctx, "test")
 	return setup
 }
 
+func (s *TestSetup) QueryClient() v1.QueryClient {
+	helper := baseapp.NewQueryServerTestHelper(s.Ctx, codectypes.NewInterfaceRegistry())
+	v1.RegisterQueryServer(helper, s.Keeper)
+
+	return v1.NewQueryClient(helper)
+}
+
 func (s *TestSetup) CreateDid(pubKey ed25519.PublicKey, did string) *v1.MsgCreateDidPayload {
 	PublicKeyMultibase := "z" + base58.Encode(pubKey)
 
@@ -155,6 +177,150 @@ func (s *TestSetup) WrapUpdateRequest(payload *v1.MsgUpdateDidPayload, keys map[
 	}
 }
 
+func (s *TestSetup) WrapDeactivateRequest(payload *v1.MsgDeactivateDidPayload, keys map[string]ed25519.PrivateKey) *v1.MsgDeactivateDid {
+	var signatures []*v1.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for privKeyId, privKey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput))
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: privKeyId,
+			Signature:            signature,
+		})
+	}
+
+	return &v1.MsgDeactivateDid{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (s *TestSetup) WrapCreateSchemaRequest(payload *v1.MsgCreateSchemaPayload, keys map[string]ed25519.PrivateKey) *v1.MsgCreateSchema {
+	var signatures []*v1.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for privKeyId, privKey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput))
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: privKeyId,
+			Signature:            signature,
+		})
+	}
+
+	return &v1.MsgCreateSchema{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (s *TestSetup) WrapCreateCredDefRequest(payload *v1.MsgCreateCredDefPayload, keys map[string]ed25519.PrivateKey) *v1.MsgCreateCredDef {
+	var signatures []*v1.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for privKeyId, privKey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput))
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: privKeyId,
+			Signature:            signature,
+		})
+	}
+
+	return &v1.MsgCreateCredDef{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (s *TestSetup) WrapCreateRevocRegDefRequest(payload *v1.MsgCreateRevocRegDefPayload, keys map[string]ed25519.PrivateKey) *v1.MsgCreateRevocRegDef {
+	var signatures []*v1.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for privKeyId, privKey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput))
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: privKeyId,
+			Signature:            signature,
+		})
+	}
+
+	return &v1.MsgCreateRevocRegDef{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (s *TestSetup) WrapCreateRevocRegEntryRequest(payload *v1.MsgCreateRevocRegEntryPayload, keys map[string]ed25519.PrivateKey) *v1.MsgCreateRevocRegEntry {
+	var signatures []*v1.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for privKeyId, privKey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput))
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: privKeyId,
+			Signature:            signature,
+		})
+	}
+
+	return &v1.MsgCreateRevocRegEntry{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (s *TestSetup) WrapCreateStatusListRequest(payload *v1.MsgCreateStatusListPayload, keys map[string]ed25519.PrivateKey) *v1.MsgCreateStatusList {
+	var signatures []*v1.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for privKeyId, privKey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput))
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: privKeyId,
+			Signature:            signature,
+		})
+	}
+
+	return &v1.MsgCreateStatusList{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (s *TestSetup) WrapUpdateStatusListRequest(payload *v1.MsgUpdateStatusListPayload, keys map[string]ed25519.PrivateKey) *v1.MsgUpdateStatusList {
+	var signatures []*v1.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for privKeyId, privKey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput))
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: privKeyId,
+			Signature:            signature,
+		})
+	}
+
+	return &v1.MsgUpdateStatusList{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (s *TestSetup) WrapCreateResourceRequest(payload *v1.MsgCreateResourcePayload, keys map[string]ed25519.PrivateKey) *v1.MsgCreateResource {
+	var signatures []*v1.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for privKeyId, privKey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, signingInput))
+		signatures = append(signatures, &v1.SignInfo{
+			VerificationMethodId: privKeyId,
+			Signature:            signature,
+		})
+	}
+
+	return &v1.MsgCreateResource{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
 func GenerateKeyPair() KeyPair {
 	PublicKey, PrivateKey, _ := ed25519.GenerateKey(rand.Reader)
 	return KeyPair{PrivateKey, PublicKey}
@@ -198,6 +364,21 @@ func (s *TestSetup) SendUpdateDid(msg *v1.MsgUpdateDidPayload, keys map[string]e
 	return updated.GetDid()
 }
 
+func (s *TestSetup) SendDeactivateDid(msg *v1.MsgDeactivateDidPayload, keys map[string]ed25519.PrivateKey) (*v1.StateValue, error) {
+	// query Did
+	state, _ := s.Keeper.GetDid(&s.Ctx, msg.Id)
+	if len(msg.VersionId) == 0 {
+		msg.VersionId = state.Metadata.VersionId
+	}
+
+	_, err := s.Handler(s.Ctx, s.WrapDeactivateRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Keeper.GetDid(&s.Ctx, msg.Id)
+}
+
 func (s *TestSetup) SendCreateDid(msg *v1.MsgCreateDidPayload, keys map[string]ed25519.PrivateKey) (*v1.Did, error) {
 	_, err := s.Handler(s.Ctx, s.WrapCreateRequest(msg, keys))
 	if err != nil {
@@ -208,6 +389,109 @@ func (s *TestSetup) SendCreateDid(msg *v1.MsgCreateDidPayload, keys map[string]e
 	return created.GetDid()
 }
 
+func (s *TestSetup) SendCreateSchema(msg *v1.MsgCreateSchemaPayload, keys map[string]ed25519.PrivateKey) (*v1.Schema, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateSchemaRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	created, _ := s.Keeper.GetSchema(&s.Ctx, msg.Id)
+	return created.GetSchema()
+}
+
+func (s *TestSetup) SendCreateCredDef(msg *v1.MsgCreateCredDefPayload, keys map[string]ed25519.PrivateKey) (*v1.CredDef, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateCredDefRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	created, _ := s.Keeper.GetCredDef(&s.Ctx, msg.Id)
+	return created.GetCredDef()
+}
+
+func (s *TestSetup) SendCreateRevocRegDef(msg *v1.MsgCreateRevocRegDefPayload, keys map[string]ed25519.PrivateKey) (*v1.RevocRegDef, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateRevocRegDefRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	created, _ := s.Keeper.GetRevocRegDef(&s.Ctx, msg.Id)
+	return created.GetRevocRegDef()
+}
+
+func (s *TestSetup) SendCreateRevocRegEntry(msg *v1.MsgCreateRevocRegEntryPayload, keys map[string]ed25519.PrivateKey) (*v1.RevocRegEntry, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateRevocRegEntryRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	created, _ := s.Keeper.GetLatestRevocRegEntry(&s.Ctx, msg.RevocRegDefId)
+	return created.GetRevocRegEntry()
+}
+
+func (s *TestSetup) SendCreateStatusList(msg *v1.MsgCreateStatusListPayload, keys map[string]ed25519.PrivateKey) (*v1.StatusList, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateStatusListRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	created, _ := s.Keeper.GetStatusList(&s.Ctx, msg.Id)
+	return created.GetStatusList()
+}
+
+func (s *TestSetup) SendUpdateStatusList(msg *v1.MsgUpdateStatusListPayload, keys map[string]ed25519.PrivateKey) (*v1.StatusList, error) {
+	// query status list
+	state, _ := s.Keeper.GetStatusList(&s.Ctx, msg.Id)
+	if len(msg.VersionId) == 0 && state != nil {
+		msg.VersionId = state.Metadata.VersionId
+	}
+
+	_, err := s.Handler(s.Ctx, s.WrapUpdateStatusListRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	updated, _ := s.Keeper.GetStatusList(&s.Ctx, msg.Id)
+	return updated.GetStatusList()
+}
+
+func (s *TestSetup) SendCreateResource(msg *v1.MsgCreateResourcePayload, keys map[string]ed25519.PrivateKey) (*v1.ResourceHeader, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateResourceRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Keeper.GetResourceHeader(&s.Ctx, msg.CollectionId, strings.ToLower(msg.Id))
+}
+
+// ClearStore deletes all the keys with the prefix from the cheqd store
+func (s *TestSetup) ClearStore(keyPrefix string) {
+	store := prefix.NewStore(s.Ctx.KVStore(s.Keeper.StoreKey()), v1.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (s *TestSetup) InitCredDef(keys map[string]ed25519.PrivateKey, issuer string) error {
+	schemaMsg := v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{issuer})
+	if _, err := s.SendCreateSchema(schemaMsg, keys); err != nil {
+		return err
+	}
+
+	credDefMsg := v1.NewMsgCreateCredDefPayload(CredDefDID, SchemaDID, v1.CredDefSignatureType, "", &v1.CredDefValue{Primary: "{}"}, []string{issuer})
+	_, err := s.SendCreateCredDef(credDefMsg, keys)
+	return err
+}
+
 func ConcatKeys(dst map[string]ed25519.PrivateKey, src map[string]ed25519.PrivateKey) map[string]ed25519.PrivateKey {
 	for k, v := range src {
 		dst[k] = v
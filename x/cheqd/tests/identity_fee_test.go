@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+)
+
+func identityFeeParams() v1.Params {
+	params := v1.DefaultParams()
+	params.IdentityFees = []*v1.IdentityFee{
+		{MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgCreateDid{}), Amount: []*sdk.Coin{{Denom: "ncheq", Amount: sdk.NewInt(50)}}},
+		{MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgUpdateDid{}), Amount: []*sdk.Coin{{Denom: "ncheq", Amount: sdk.NewInt(25)}}},
+	}
+	params.BurnFactor = "0.5"
+
+	return params
+}
+
+func TestIdentityFee_ChangeProposal(t *testing.T) {
+	setup := Setup()
+	handler := params.NewParamChangeProposalHandler(setup.ParamsKeeper)
+
+	change := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		{Subspace: v1.ModuleName, Key: string(v1.KeyIdentityFees), Value: `[{"msg_type_url":"/cheqdid.cheqdnode.cheqd.v1.MsgCreateDid","amount":[{"denom":"ncheq","amount":"50"}]},{"msg_type_url":"/cheqdid.cheqdnode.cheqd.v1.MsgUpdateDid","amount":[{"denom":"ncheq","amount":"25"}]}]`},
+		{Subspace: v1.ModuleName, Key: string(v1.KeyBurnFactor), Value: `"0.5"`},
+	})
+	require.Nil(t, handler(setup.Ctx, change))
+
+	actual := setup.Keeper.GetParams(setup.Ctx)
+	require.Equal(t, identityFeeParams().IdentityFees, actual.IdentityFees)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), actual.GetBurnFactorDec())
+
+	invalid := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		{Subspace: v1.ModuleName, Key: string(v1.KeyBurnFactor), Value: `"1.5"`},
+	})
+	require.Error(t, handler(setup.Ctx, invalid))
+}
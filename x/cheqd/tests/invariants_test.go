@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvariants(t *testing.T) {
+	cases := []struct {
+		name    string
+		corrupt func(setup TestSetup)
+		errMsg  string
+	}{
+		{
+			name:    "Wrong DID count",
+			corrupt: func(setup TestSetup) { setup.Keeper.SetDidCount(setup.Ctx, 3) },
+			errMsg:  "stored DID count: 3\n\tDIDs in the store: 2",
+		},
+		{
+			name: "State value without DID Doc",
+			corrupt: func(setup TestSetup) {
+				stateValue := &v1.StateValue{Data: &types.Any{TypeUrl: v1.StateValueSchema}, Metadata: &v1.Metadata{}}
+				setup.Keeper.SetDidStateValue(setup.Ctx, BobDID, stateValue)
+			},
+			errMsg: "1 DID state values can't be decoded",
+		},
+		{
+			name: "Unknown controller",
+			corrupt: func(setup TestSetup) {
+				state, _ := setup.Keeper.GetDid(&setup.Ctx, BobDID)
+				did, _ := state.GetDid()
+				did.Controller = []string{CharlieDID}
+
+				stateValue, _ := v1.NewStateValue(did, state.Metadata)
+				setup.Keeper.SetDidStateValue(setup.Ctx, BobDID, stateValue)
+			},
+			errMsg: "1 DID Docs have unresolvable controllers",
+		},
+		{
+			name:    "Another namespace",
+			corrupt: func(setup TestSetup) { setup.Keeper.SetDidNamespace(setup.Ctx, "mainnet") },
+			errMsg:  "2 DID Docs are invalid",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+
+			_, _, _ = setup.InitDid(AliceDID)
+			_, _, _ = setup.InitDid(BobDID)
+
+			res, broken := keeper.AllInvariants(setup.Keeper)(setup.Ctx)
+			require.False(t, broken, res)
+
+			tc.corrupt(setup)
+
+			res, broken = keeper.AllInvariants(setup.Keeper)(setup.Ctx)
+			require.True(t, broken)
+			require.Contains(t, res, tc.errMsg)
+		})
+	}
+}
+
+func TestInvariants_ParamChanges(t *testing.T) {
+	setup := Setup()
+
+	_, _, _ = setup.InitDid(AliceDID)
+	_, _, _ = setup.InitDid(BobDID)
+
+	// DID Docs written before the params change stay valid
+	params := setup.Keeper.GetParams(setup.Ctx)
+	params.VerificationMethodTypes = params.VerificationMethodTypes[:1] // JsonWebKey2020 only
+	params.MaxVerificationMethods = 1
+	params.MaxDidDocSize = 1
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	res, broken := keeper.AllInvariants(setup.Keeper)(setup.Ctx)
+	require.False(t, broken, res)
+}
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper/migrations"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	setup := Setup()
+
+	// Version 1 stored the namespace under the default namespace
+	store := prefix.NewStore(setup.Ctx.KVStore(setup.Keeper.StoreKey()), v1.KeyPrefix(migrations.LegacyDidNamespaceKey))
+	legacyKey := v1.KeyPrefix(migrations.LegacyDidNamespaceKey)
+	store.Set(legacyKey, []byte("mainnet"))
+
+	require.Nil(t, migrations.NewMigrator(setup.Keeper).Migrate1to2(setup.Ctx))
+
+	require.Equal(t, "mainnet", setup.Keeper.GetDidNamespace(setup.Ctx))
+	require.False(t, store.Has(legacyKey))
+}
+
+func TestMigrate1to2_WithoutLegacyNamespace(t *testing.T) {
+	setup := Setup()
+
+	require.Nil(t, migrations.NewMigrator(setup.Keeper).Migrate1to2(setup.Ctx))
+	require.Equal(t, "test", setup.Keeper.GetDidNamespace(setup.Ctx))
+}
+
+func TestMigrate1to2_BackfillsDids(t *testing.T) {
+	setup := Setup()
+
+	_, aliceMsg, _ := setup.InitDid(AliceDID)
+	aliceKey := aliceMsg.VerificationMethod[0].PublicKeyMultibase
+
+	// Version 1 stored only the latest state of the DID Docs
+	controlled := "did:cheqd:test:controlled"
+	state, _ := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	stateValue, err := v1.NewStateValue(&v1.Did{Id: controlled, Controller: []string{AliceDID}}, state.Metadata)
+	require.Nil(t, err)
+
+	setup.Keeper.SetDidStateValue(setup.Ctx, controlled, stateValue)
+	setup.Keeper.SetDidCount(setup.Ctx, 2)
+
+	for _, key := range []string{v1.DidVersionKey, v1.DidVersionCountKey, v1.DidVersionIdKey, v1.DidControllerKey, v1.DidPublicKeyKey} {
+		setup.ClearStore(key)
+	}
+
+	require.Nil(t, migrations.NewMigrator(setup.Keeper).Migrate1to2(setup.Ctx))
+
+	require.ElementsMatch(t, []string{AliceDID, controlled}, queryDidsByController(t, setup, AliceDID))
+	require.Equal(t, []*v1.VerificationMethodReference{{Did: AliceDID, VerificationMethodId: AliceKey1}}, queryDidsByPublicKey(t, setup, aliceKey))
+
+	for _, id := range []string{AliceDID, controlled} {
+		res, err := setup.Keeper.DidVersions(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetDidVersionsRequest{Id: id})
+		require.Nil(t, err)
+		require.Equal(t, []*v1.Metadata{state.Metadata}, res.Versions)
+	}
+
+	// The migration can run again without duplicating the history
+	require.Nil(t, migrations.NewMigrator(setup.Keeper).Migrate1to2(setup.Ctx))
+	require.Equal(t, uint64(1), setup.Keeper.GetDidVersionCount(setup.Ctx, controlled))
+
+	res, broken := keeper.AllInvariants(setup.Keeper)(setup.Ctx)
+	require.False(t, broken, res)
+}
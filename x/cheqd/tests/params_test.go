@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"github.com/cheqd/cheqd-node/x/cheqd"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_DefaultsBeforeSet(t *testing.T) {
+	setup := Setup()
+	require.Equal(t, v1.DefaultParams(), setup.Keeper.GetParams(setup.Ctx))
+}
+
+func TestParams_ServiceTypes(t *testing.T) {
+	setup := Setup()
+
+	keyPair := GenerateKeyPair()
+	keys := map[string]ed25519.PrivateKey{AliceKey1: keyPair.PrivateKey}
+
+	msg := setup.CreateDid(keyPair.PublicKey, AliceDID)
+	msg.Service[0].Type = "LinkedVerifiablePresentation"
+
+	_, err := setup.SendCreateDid(msg, keys)
+	require.EqualError(t, err, "index 0, value #service-2: LinkedVerifiablePresentation: unsupported service type: bad request: invalid service")
+
+	params := v1.DefaultParams()
+	params.ServiceTypes = append(params.ServiceTypes, "LinkedVerifiablePresentation")
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	_, err = setup.SendCreateDid(msg, keys)
+	require.Nil(t, err)
+}
+
+func TestParams_VerificationMethodTypes(t *testing.T) {
+	setup := Setup()
+
+	params := v1.DefaultParams()
+	params.VerificationMethodTypes = params.VerificationMethodTypes[:1]
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	_, _, err := setup.InitDid(AliceDID)
+	require.EqualError(t, err, "index 0, value did:cheqd:test:alice#key-1: Ed25519VerificationKey2020: unsupported verification method type: bad request: invalid verification method")
+}
+
+func TestParams_Limits(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, aliceMsg, _ := setup.InitDid(AliceDID)
+
+	params := v1.DefaultParams()
+	params.MaxControllers = 1
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	updateMsg := setup.CreateToUpdateDid(aliceMsg)
+	updateMsg.Controller = []string{AliceDID, BobDID}
+
+	_, err := setup.SendUpdateDid(updateMsg, aliceKeys)
+	require.EqualError(t, err, "DID Doc can't have more than 1 controllers: bad request")
+
+	params.MaxDidDocSize = 64
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	_, _, err = setup.InitDid(BobDID)
+	require.Contains(t, err.Error(), "exceeds 64 bytes")
+}
+
+func TestParams_ChangeProposal(t *testing.T) {
+	setup := Setup()
+	handler := params.NewParamChangeProposalHandler(setup.ParamsKeeper)
+
+	change := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		{Subspace: v1.ModuleName, Key: string(v1.KeyServiceTypes), Value: `["LinkedDomains","LinkedVerifiablePresentation"]`},
+		{Subspace: v1.ModuleName, Key: string(v1.KeyVerificationMethodTypes), Value: `[{"type":"JsonWebKey2020","materials":["PublicKeyJwk"]}]`},
+		{Subspace: v1.ModuleName, Key: string(v1.KeyMaxControllers), Value: `"5"`},
+	})
+	require.Nil(t, handler(setup.Ctx, change))
+
+	expected := v1.DefaultParams()
+	expected.ServiceTypes = []string{"LinkedDomains", "LinkedVerifiablePresentation"}
+	expected.VerificationMethodTypes = expected.VerificationMethodTypes[:1]
+	expected.MaxControllers = 5
+	require.Equal(t, expected, setup.Keeper.GetParams(setup.Ctx))
+
+	invalid := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		{Subspace: v1.ModuleName, Key: string(v1.KeyMaxControllers), Value: `"0"`},
+	})
+	require.Error(t, handler(setup.Ctx, invalid))
+}
+
+func TestParams_QueryAndGenesis(t *testing.T) {
+	setup := Setup()
+
+	params := v1.DefaultParams()
+	params.MaxServices = 3
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	res, err := setup.QueryClient().Params(sdk.WrapSDKContext(setup.Ctx), &v1.QueryParamsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, params, *res.Params)
+
+	genesis := cheqd.ExportGenesis(setup.Ctx, setup.Keeper)
+	require.Equal(t, params, *genesis.Params)
+
+	imported := Setup()
+	cheqd.InitGenesis(imported.Ctx, imported.Keeper, *genesis)
+	require.Equal(t, params, imported.Keeper.GetParams(imported.Ctx))
+}
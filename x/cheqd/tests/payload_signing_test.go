@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"github.com/cheqd/cheqd-node/x/cheqd/client/cli"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadSigning_UpdateByManyControllers(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	controlled := "did:cheqd:test:controlled"
+	createMsg := &v1.MsgCreateDidPayload{
+		Id:         controlled,
+		Controller: []string{AliceDID, BobDID},
+	}
+
+	_, err := setup.SendCreateDid(createMsg, ConcatKeys(ConcatKeys(map[string]ed25519.PrivateKey{}, aliceKeys), bobKeys))
+	require.Nil(t, err)
+
+	state, _ := setup.Keeper.GetDid(&setup.Ctx, controlled)
+
+	// Bob is removed, but he still has to sign the update
+	updateMsg := setup.CreateToUpdateDid(createMsg)
+	updateMsg.Controller = []string{AliceDID}
+	updateMsg.VersionId = state.Metadata.VersionId
+
+	aliceSigned := setup.WrapUpdateRequest(updateMsg, aliceKeys)
+	status := getSigningStatus(t, setup, aliceSigned)
+	require.False(t, status.Complete)
+	require.Equal(t, []cli.SignerStatus{
+		{Did: AliceDID, Status: cli.SignerStatusSigned},
+		{Did: BobDID, Status: cli.SignerStatusMissing},
+	}, status.Signers)
+
+	// Bob's signature is made by a different key
+	_, wrongKey, _ := ed25519.GenerateKey(rand.Reader)
+	wrongSigned := setup.WrapUpdateRequest(updateMsg, map[string]ed25519.PrivateKey{BobKey1: wrongKey})
+
+	merged, err := cli.MergeIdentityMsgs([]sdk.Msg{aliceSigned, wrongSigned})
+	require.Nil(t, err)
+
+	status = getSigningStatus(t, setup, merged)
+	require.False(t, status.Complete)
+	require.Equal(t, cli.SignerStatusInvalid, status.Signers[1].Status)
+
+	bobSigned := setup.WrapUpdateRequest(updateMsg, bobKeys)
+
+	merged, err = cli.MergeIdentityMsgs([]sdk.Msg{aliceSigned, bobSigned, aliceSigned})
+	require.Nil(t, err)
+	require.Len(t, merged.(*v1.MsgUpdateDid).Signatures, 2)
+
+	status = getSigningStatus(t, setup, merged)
+	require.True(t, status.Complete)
+
+	_, err = setup.Handler(setup.Ctx, merged)
+	require.Nil(t, err)
+}
+
+func TestPayloadSigning_MergeDifferentPayloads(t *testing.T) {
+	setup := Setup()
+
+	first := setup.WrapCreateRequest(&v1.MsgCreateDidPayload{Id: AliceDID}, nil)
+	second := setup.WrapCreateRequest(&v1.MsgCreateDidPayload{Id: BobDID}, nil)
+	update := setup.WrapUpdateRequest(&v1.MsgUpdateDidPayload{Id: AliceDID}, nil)
+
+	_, err := cli.MergeIdentityMsgs([]sdk.Msg{first, second})
+	require.EqualError(t, err, "signing files contain different payloads")
+
+	_, err = cli.MergeIdentityMsgs([]sdk.Msg{first, update})
+	require.EqualError(t, err, "signing files contain different payloads")
+}
+
+func getSigningStatus(t *testing.T, setup TestSetup, msg sdk.Msg) *cli.SigningStatus {
+	status, err := cli.GetSigningStatus(context.Background(), setup.QueryClient(), msg)
+	require.Nil(t, err)
+
+	return status
+}
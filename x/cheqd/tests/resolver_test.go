@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cheqd/cheqd-node/x/cheqd/client/rest"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDid(t *testing.T) {
+	setup := Setup()
+
+	_, didMsg, _ := setup.InitDid(AliceDID)
+	queryClient := setup.QueryClient()
+
+	cases := []struct {
+		name   string
+		did    string
+		status int
+		error  string
+	}{
+		{"Valid DID", AliceDID, http.StatusOK, ""},
+		{"Unknown DID", "did:cheqd:test:unknown", http.StatusNotFound, v1.ResolutionNotFound},
+		{"Invalid DID", "did:cheqd:test:alice#key-1", http.StatusBadRequest, v1.ResolutionInvalidDid},
+		{"Other method", "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", http.StatusNotImplemented, v1.ResolutionMethodNotSupported},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, status := rest.ResolveDid(context.Background(), queryClient, tc.did)
+
+			require.Equal(t, tc.status, status)
+			require.Equal(t, tc.error, result.DidResolutionMetadata.Error)
+
+			if len(tc.error) > 0 {
+				require.Nil(t, result.DidDocument)
+				require.Nil(t, result.DidDocumentMetadata)
+				return
+			}
+
+			require.Equal(t, v1.DidLdJsonContentType, result.DidResolutionMetadata.ContentType)
+			require.Equal(t, didMsg.Id, result.DidDocument.Id)
+			require.Equal(t, "2021-01-01T00:00:00Z", result.DidDocumentMetadata.Created)
+			require.Empty(t, result.DidDocumentMetadata.Updated)
+			require.NotEmpty(t, result.DidDocumentMetadata.VersionId)
+		})
+	}
+}
+
+func TestResolveDidJsonLd(t *testing.T) {
+	setup := Setup()
+
+	_, didMsg, _ := setup.InitDid(AliceDID)
+
+	result, _ := rest.ResolveDid(context.Background(), setup.QueryClient(), AliceDID)
+	bytes, err := json.Marshal(result)
+	require.Nil(t, err)
+
+	var output map[string]interface{}
+	require.Nil(t, json.Unmarshal(bytes, &output))
+	require.Equal(t, v1.DidResolutionContext, output["@context"])
+
+	didDoc := output["didDocument"].(map[string]interface{})
+	require.Equal(t, []interface{}{didMsg.Context[0]}, didDoc["@context"])
+
+	vm := didDoc["verificationMethod"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, AliceDID+"#key-1", vm["id"])
+	require.NotEmpty(t, vm["publicKeyMultibase"])
+}
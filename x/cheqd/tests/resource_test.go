@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ResourceID     = "a09abea0-22e0-4b35-8f70-9cc3a6d0b5fd"
+	NextResourceID = "b53d6a1c-0d4e-4c4b-9a4e-4d5c7a1f0e2b"
+)
+
+func TestHandler_CreateResource(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	data := []byte("{\"attr_names\": [\"first_name\"]}")
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *v1.MsgCreateResourcePayload
+		keys   map[string]ed25519.PrivateKey
+		errMsg string
+	}{
+		{
+			valid:  false,
+			name:   "Unknown collection",
+			msg:    v1.NewMsgCreateResourcePayload("did:cheqd:test:unknown", ResourceID, "Degree", "CL-Schema", "application/json", data),
+			keys:   aliceKeys,
+			errMsg: "did:cheqd:test:unknown: DID Doc not found",
+		},
+		{
+			valid:  false,
+			name:   "Not collection controller signature",
+			msg:    v1.NewMsgCreateResourcePayload(AliceDID, ResourceID, "Degree", "CL-Schema", "application/json", data),
+			keys:   bobKeys,
+			errMsg: "signature did:cheqd:test:alice not found: invalid signature detected",
+		},
+		{
+			valid: true,
+			name:  "Valid resource",
+			msg:   v1.NewMsgCreateResourcePayload(AliceDID, ResourceID, "Degree", "CL-Schema", "application/json", data),
+			keys:  aliceKeys,
+		},
+		{
+			valid:  false,
+			name:   "Uppercase id of existing resource",
+			msg:    v1.NewMsgCreateResourcePayload(AliceDID, strings.ToUpper(ResourceID), "Degree", "CL-Schema", "application/json", data),
+			keys:   aliceKeys,
+			errMsg: "resource did:cheqd:test:alice/a09abea0-22e0-4b35-8f70-9cc3a6d0b5fd already exists: resource exists",
+		},
+		{
+			valid:  false,
+			name:   "Resource already exists",
+			msg:    v1.NewMsgCreateResourcePayload(AliceDID, ResourceID, "Degree", "CL-Schema", "application/json", data),
+			keys:   aliceKeys,
+			errMsg: "resource did:cheqd:test:alice/a09abea0-22e0-4b35-8f70-9cc3a6d0b5fd already exists: resource exists",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			header, err := setup.SendCreateResource(tc.msg, tc.keys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, tc.msg.MediaType, header.MediaType)
+				require.Equal(t, v1.GetChecksum(data), header.Checksum)
+				require.Empty(t, header.PreviousVersionId)
+				require.Empty(t, header.NextVersionId)
+
+				created, err := time.Parse(v1.MetadataTimeLayout, header.Created)
+				require.Nil(t, err)
+				require.Equal(t, setup.Ctx.BlockTime().Unix(), created.Unix())
+
+				stored, err := setup.Keeper.GetResourceData(&setup.Ctx, tc.msg.CollectionId, tc.msg.Id)
+				require.Nil(t, err)
+				require.Equal(t, data, stored)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestHandler_CreateResourceVersion(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+
+	first, err := setup.SendCreateResource(v1.NewMsgCreateResourcePayload(AliceDID, ResourceID, "Degree", "CL-Schema", "application/json", []byte("1")), aliceKeys)
+	require.Nil(t, err)
+
+	second, err := setup.SendCreateResource(v1.NewMsgCreateResourcePayload(AliceDID, NextResourceID, "Degree", "CL-Schema", "application/json", []byte("2")), aliceKeys)
+	require.Nil(t, err)
+	require.Equal(t, first.Id, second.PreviousVersionId)
+	require.Empty(t, second.NextVersionId)
+
+	first, err = setup.Keeper.GetResourceHeader(&setup.Ctx, AliceDID, ResourceID)
+	require.Nil(t, err)
+	require.Equal(t, second.Id, first.NextVersionId)
+
+	latest, err := setup.Keeper.GetLatestResourceVersion(&setup.Ctx, AliceDID, "Degree", "CL-Schema")
+	require.Nil(t, err)
+	require.Equal(t, second.Id, latest.Id)
+
+	// Lowercase UUIDs are stored
+	third, err := setup.SendCreateResource(v1.NewMsgCreateResourcePayload(AliceDID, "C2A1F2E4-5B6D-4E7F-8A9B-0C1D2E3F4A5B", "Degree", "CL-Schema", "application/json", []byte("3")), aliceKeys)
+	require.Nil(t, err)
+	require.Equal(t, "c2a1f2e4-5b6d-4e7f-8a9b-0c1d2e3f4a5b", third.Id)
+	require.Equal(t, second.Id, third.PreviousVersionId)
+
+	// Another name starts a new chain of versions
+	other, err := setup.SendCreateResource(v1.NewMsgCreateResourcePayload(AliceDID, "f1c1b0a4-3e9a-4d7e-8a53-6d1b8a0c2f7e", "Logo", "CL-Schema", "image/png", []byte("4")), aliceKeys)
+	require.Nil(t, err)
+	require.Empty(t, other.PreviousVersionId)
+
+	res, err := setup.Keeper.CollectionResources(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetCollectionResourcesRequest{CollectionId: AliceDID})
+	require.Nil(t, err)
+	require.Len(t, res.Resources, 4)
+
+	dataRes, err := setup.Keeper.ResourceData(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetResourceDataRequest{CollectionId: AliceDID, Id: NextResourceID})
+	require.Nil(t, err)
+	require.Equal(t, "application/json", dataRes.MediaType)
+	require.Equal(t, []byte("2"), dataRes.Data)
+}
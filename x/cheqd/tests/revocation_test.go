@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func NewRevocRegDefValue(issuanceType string) *v1.RevocRegDefValue {
+	return &v1.RevocRegDefValue{
+		MaxCredNum:    100,
+		TailsHash:     "6619ad3cf7e02fc29931a5cdc7bb70ba4b9283bda3badae297",
+		TailsLocation: "http://tails.location.com",
+		IssuanceType:  issuanceType,
+	}
+}
+
+func TestHandler_CreateRevocRegDef(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+	require.Nil(t, setup.InitCredDef(aliceKeys, AliceDID))
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *v1.MsgCreateRevocRegDefPayload
+		keys   map[string]ed25519.PrivateKey
+		errMsg string
+	}{
+		{
+			valid:  false,
+			name:   "Unknown cred def",
+			msg:    v1.NewMsgCreateRevocRegDefPayload(RevocRegDefDID, "did:cheqd:test:unknown", v1.RevocDefTypeCLAccum, "tag1", NewRevocRegDefValue(v1.IssuanceByDefault)),
+			keys:   aliceKeys,
+			errMsg: "cred def did:cheqd:test:unknown: resource not found",
+		},
+		{
+			valid:  false,
+			name:   "Not issuer signature",
+			msg:    v1.NewMsgCreateRevocRegDefPayload(RevocRegDefDID, CredDefDID, v1.RevocDefTypeCLAccum, "tag1", NewRevocRegDefValue(v1.IssuanceByDefault)),
+			keys:   bobKeys,
+			errMsg: "signature did:cheqd:test:alice not found: invalid signature detected",
+		},
+		{
+			valid: true,
+			name:  "Valid revoc reg def",
+			msg:   v1.NewMsgCreateRevocRegDefPayload(RevocRegDefDID, CredDefDID, v1.RevocDefTypeCLAccum, "tag1", NewRevocRegDefValue(v1.IssuanceByDefault)),
+			keys:  aliceKeys,
+		},
+		{
+			valid:  false,
+			name:   "Revoc reg def already exists",
+			msg:    v1.NewMsgCreateRevocRegDefPayload(RevocRegDefDID, CredDefDID, v1.RevocDefTypeCLAccum, "tag2", NewRevocRegDefValue(v1.IssuanceByDefault)),
+			keys:   aliceKeys,
+			errMsg: "revoc reg def did:cheqd:test:revoc-reg-def already exists: resource exists",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			revocRegDef, err := setup.SendCreateRevocRegDef(tc.msg, tc.keys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, tc.msg.CredDefId, revocRegDef.CredDefId)
+				require.Equal(t, tc.msg.Value, revocRegDef.Value)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestHandler_CreateRevocRegEntry(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+	require.Nil(t, setup.InitCredDef(aliceKeys, AliceDID))
+
+	defMsg := v1.NewMsgCreateRevocRegDefPayload(RevocRegDefDID, CredDefDID, v1.RevocDefTypeCLAccum, "tag1", NewRevocRegDefValue(v1.IssuanceByDefault))
+	_, err := setup.SendCreateRevocRegDef(defMsg, aliceKeys)
+	require.Nil(t, err)
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *v1.MsgCreateRevocRegEntryPayload
+		keys   map[string]ed25519.PrivateKey
+		errMsg string
+	}{
+		{
+			valid:  false,
+			name:   "Unknown revoc reg def",
+			msg:    v1.NewMsgCreateRevocRegEntryPayload("did:cheqd:test:unknown", v1.RevocDefTypeCLAccum, &v1.RevocRegEntryValue{Accum: "1"}),
+			keys:   aliceKeys,
+			errMsg: "revoc reg def did:cheqd:test:unknown: resource not found",
+		},
+		{
+			valid:  false,
+			name:   "Index exceeds MaxCredNum",
+			msg:    v1.NewMsgCreateRevocRegEntryPayload(RevocRegDefDID, v1.RevocDefTypeCLAccum, &v1.RevocRegEntryValue{Accum: "1", Revoked: []uint64{100}}),
+			keys:   aliceKeys,
+			errMsg: "Value index 100 exceeds MaxCredNum 100: bad request",
+		},
+		{
+			valid:  false,
+			name:   "Not issuer signature",
+			msg:    v1.NewMsgCreateRevocRegEntryPayload(RevocRegDefDID, v1.RevocDefTypeCLAccum, &v1.RevocRegEntryValue{Accum: "1", Revoked: []uint64{1}}),
+			keys:   bobKeys,
+			errMsg: "signature did:cheqd:test:alice not found: invalid signature detected",
+		},
+		{
+			valid: true,
+			name:  "First entry",
+			msg:   v1.NewMsgCreateRevocRegEntryPayload(RevocRegDefDID, v1.RevocDefTypeCLAccum, &v1.RevocRegEntryValue{Accum: "1", Revoked: []uint64{1}}),
+			keys:  aliceKeys,
+		},
+		{
+			valid:  false,
+			name:   "Unexpected previous accumulator",
+			msg:    v1.NewMsgCreateRevocRegEntryPayload(RevocRegDefDID, v1.RevocDefTypeCLAccum, &v1.RevocRegEntryValue{Accum: "2", PrevAccum: "0", Revoked: []uint64{2}}),
+			keys:   aliceKeys,
+			errMsg: "Expected did:cheqd:test:revoc-reg-def with previous accumulator 1. Got 0: unexpected previous accumulator",
+		},
+		{
+			valid: true,
+			name:  "Second entry",
+			msg:   v1.NewMsgCreateRevocRegEntryPayload(RevocRegDefDID, v1.RevocDefTypeCLAccum, &v1.RevocRegEntryValue{Accum: "2", PrevAccum: "1", Revoked: []uint64{2}}),
+			keys:  aliceKeys,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			entry, err := setup.SendCreateRevocRegEntry(tc.msg, tc.keys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, tc.msg.Value, entry.Value)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestQueryRevocReg(t *testing.T) {
+	setup := Setup()
+	created := setup.Ctx.BlockTime()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	require.Nil(t, setup.InitCredDef(aliceKeys, AliceDID))
+
+	defMsg := v1.NewMsgCreateRevocRegDefPayload(RevocRegDefDID, CredDefDID, v1.RevocDefTypeCLAccum, "tag1", NewRevocRegDefValue(v1.IssuanceByDefault))
+	_, err := setup.SendCreateRevocRegDef(defMsg, aliceKeys)
+	require.Nil(t, err)
+
+	// One entry per hour
+	values := []*v1.RevocRegEntryValue{
+		{Accum: "1", Revoked: []uint64{1, 2}},
+		{Accum: "2", PrevAccum: "1", Issued: []uint64{1}, Revoked: []uint64{3}},
+		{Accum: "3", PrevAccum: "2", Revoked: []uint64{4}},
+	}
+
+	for i, value := range values {
+		setup.Ctx = setup.Ctx.WithBlockTime(created.Add(time.Duration(i+1) * time.Hour)).WithTxBytes([]byte(value.Accum))
+		_, err := setup.SendCreateRevocRegEntry(v1.NewMsgCreateRevocRegEntryPayload(RevocRegDefDID, v1.RevocDefTypeCLAccum, value), aliceKeys)
+		require.Nil(t, err)
+	}
+
+	goCtx := sdk.WrapSDKContext(setup.Ctx)
+
+	defResponse, err := setup.Keeper.RevocRegDef(goCtx, &v1.QueryGetRevocRegDefRequest{Id: RevocRegDefDID})
+	require.Nil(t, err)
+	require.Equal(t, CredDefDID, defResponse.RevocRegDef.CredDefId)
+
+	accumCases := []struct {
+		name      string
+		timestamp string
+		accum     string
+		errMsg    string
+	}{
+		{"Latest", "", "3", ""},
+		{"Before the first entry", created.Format(time.RFC3339), "", "entry of did:cheqd:test:revoc-reg-def: resource not found"},
+		{"At the first entry", created.Add(time.Hour).Format(time.RFC3339), "1", ""},
+		{"Between entries", created.Add(150 * time.Minute).Format(time.RFC3339), "2", ""},
+		{"Invalid timestamp", "yesterday", "", "rpc error: code = InvalidArgument desc = timestamp must be in RFC 3339 format"},
+	}
+
+	for _, tc := range accumCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := setup.Keeper.RevocRegAccum(goCtx, &v1.QueryGetRevocRegAccumRequest{RevocRegDefId: RevocRegDefDID, Timestamp: tc.timestamp})
+
+			if tc.errMsg == "" {
+				require.Nil(t, err)
+				require.Equal(t, tc.accum, response.RevocRegEntry.Value.Accum)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+
+	deltaCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected v1.RevocRegDelta
+	}{
+		{
+			"Whole history",
+			"",
+			"",
+			v1.RevocRegDelta{RevocRegDefId: RevocRegDefDID, Accum: "3", Revoked: []uint64{2, 3, 4}},
+		},
+		{
+			"From the first entry",
+			created.Add(time.Hour).Format(time.RFC3339),
+			"",
+			v1.RevocRegDelta{RevocRegDefId: RevocRegDefDID, Accum: "3", PrevAccum: "1", Issued: []uint64{1}, Revoked: []uint64{3, 4}},
+		},
+		{
+			"Between the first and the second entries",
+			created.Add(time.Hour).Format(time.RFC3339),
+			created.Add(2 * time.Hour).Format(time.RFC3339),
+			v1.RevocRegDelta{RevocRegDefId: RevocRegDefDID, Accum: "2", PrevAccum: "1", Issued: []uint64{1}, Revoked: []uint64{3}},
+		},
+	}
+
+	for _, tc := range deltaCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := setup.Keeper.RevocRegDelta(goCtx, &v1.QueryGetRevocRegDeltaRequest{RevocRegDefId: RevocRegDefDID, From: tc.from, To: tc.to})
+			require.Nil(t, err)
+			require.Equal(t, tc.expected, *response.RevocRegDelta)
+		})
+	}
+
+	_, err = setup.Keeper.RevocRegDelta(goCtx, &v1.QueryGetRevocRegDeltaRequest{
+		RevocRegDefId: RevocRegDefDID,
+		From:          created.Add(2 * time.Hour).Format(time.RFC3339),
+		To:            created.Format(time.RFC3339),
+	})
+	require.Error(t, err)
+	require.Equal(t, "rpc error: code = InvalidArgument desc = to must not be before from", err.Error())
+}
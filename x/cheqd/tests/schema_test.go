@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CreateSchema(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *v1.MsgCreateSchemaPayload
+		keys   map[string]ed25519.PrivateKey
+		errMsg string
+	}{
+		{
+			valid: false,
+			name:  "Not controller signature",
+			msg: v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0",
+				[]string{AliceDID}),
+			keys:   bobKeys,
+			errMsg: "signature did:cheqd:test:alice not found: invalid signature detected",
+		},
+		{
+			valid: false,
+			name:  "Unknown controller",
+			msg: v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0",
+				[]string{"did:cheqd:test:unknown"}),
+			keys:   aliceKeys,
+			errMsg: "did:cheqd:test:unknown: DID Doc not found",
+		},
+		{
+			valid: false,
+			name:  "Not all controllers signed",
+			msg: v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0",
+				[]string{AliceDID, BobDID}),
+			keys:   aliceKeys,
+			errMsg: "signature did:cheqd:test:bob not found: invalid signature detected",
+		},
+		{
+			valid: false,
+			name:  "Schema id is a DID",
+			msg: v1.NewMsgCreateSchemaPayload(BobDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0",
+				[]string{AliceDID}),
+			keys:   aliceKeys,
+			errMsg: "DID is already used by DIDDoc did:cheqd:test:bob: DID Doc exists",
+		},
+		{
+			valid: true,
+			name:  "Valid schema",
+			msg: v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name", "last_name"}, "Degree", "1.0",
+				[]string{AliceDID, BobDID}),
+			keys: ConcatKeys(ConcatKeys(map[string]ed25519.PrivateKey{}, aliceKeys), bobKeys),
+		},
+		{
+			valid: false,
+			name:  "Schema already exists",
+			msg: v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "2.0",
+				[]string{AliceDID}),
+			keys:   aliceKeys,
+			errMsg: "schema did:cheqd:test:schema already exists: resource exists",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			schema, err := setup.SendCreateSchema(tc.msg, tc.keys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, tc.msg.Id, schema.Id)
+				require.Equal(t, tc.msg.AttrNames, schema.AttrNames)
+				require.Equal(t, tc.msg.Controller, schema.Controller)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestHandler_CreateSchemaByIssuerController(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	// Issuer DID Doc controlled by Alice only
+	issuerDID := "did:cheqd:test:issuer"
+	_, err := setup.SendCreateDid(&v1.MsgCreateDidPayload{Id: issuerDID, Controller: []string{AliceDID}}, aliceKeys)
+	require.Nil(t, err)
+
+	msg := v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{issuerDID})
+
+	_, err = setup.SendCreateSchema(msg, bobKeys)
+	require.Error(t, err)
+	require.Equal(t, "signature did:cheqd:test:alice not found: invalid signature detected", err.Error())
+
+	schema, err := setup.SendCreateSchema(msg, aliceKeys)
+	require.Nil(t, err)
+	require.Equal(t, []string{issuerDID}, schema.Controller)
+}
+
+func TestHandler_CreateDidWithSchemaId(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	msg := v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{AliceDID})
+	_, err := setup.SendCreateSchema(msg, aliceKeys)
+	require.Nil(t, err)
+
+	// Schema ids share the DID namespace, so a DID Doc can't take one
+	_, _, err = setup.InitDid(SchemaDID)
+	require.Error(t, err)
+	require.Equal(t, "DID is already used by schema did:cheqd:test:schema: resource exists", err.Error())
+	require.False(t, setup.Keeper.HasDid(setup.Ctx, SchemaDID))
+}
+
+func TestHandler_CreateSchemaByDeactivatedController(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	_, err := setup.SendDeactivateDid(v1.NewMsgDeactivateDidPayload(AliceDID, ""), aliceKeys)
+	require.Nil(t, err)
+
+	msg := v1.NewMsgCreateSchemaPayload(SchemaDID, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{AliceDID})
+	_, err = setup.SendCreateSchema(msg, aliceKeys)
+	require.Error(t, err)
+	require.Equal(t, "did:cheqd:test:alice: DID Doc deactivated", err.Error())
+}
+
+func TestQuerySchemas(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	ids := []string{"did:cheqd:test:schema-1", "did:cheqd:test:schema-2", "did:cheqd:test:schema-3"}
+	for _, id := range ids {
+		msg := v1.NewMsgCreateSchemaPayload(id, v1.SchemaType, []string{"first_name"}, "Degree", "1.0", []string{AliceDID})
+		_, err := setup.SendCreateSchema(msg, aliceKeys)
+		require.Nil(t, err)
+	}
+
+	goCtx := sdk.WrapSDKContext(setup.Ctx)
+
+	schemaResponse, err := setup.Keeper.Schema(goCtx, &v1.QueryGetSchemaRequest{Id: ids[1]})
+	require.Nil(t, err)
+	require.Equal(t, ids[1], schemaResponse.Schema.Id)
+	require.Equal(t, "Degree", schemaResponse.Schema.Name)
+	require.NotEmpty(t, schemaResponse.Metadata.VersionId)
+
+	_, err = setup.Keeper.Schema(goCtx, &v1.QueryGetSchemaRequest{Id: "did:cheqd:test:unknown"})
+	require.Error(t, err)
+	require.Equal(t, "did:cheqd:test:unknown: resource not found", err.Error())
+
+	firstPage, err := setup.Keeper.AllSchemas(goCtx, &v1.QueryAllSchemasRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Len(t, firstPage.Schemas, 2)
+	require.Equal(t, uint64(3), firstPage.Pagination.Total)
+	require.Equal(t, ids[0], firstPage.Schemas[0].Schema.Id)
+
+	secondPage, err := setup.Keeper.AllSchemas(goCtx, &v1.QueryAllSchemasRequest{
+		Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey},
+	})
+	require.Nil(t, err)
+	require.Len(t, secondPage.Schemas, 1)
+	require.Equal(t, ids[2], secondPage.Schemas[0].Schema.Id)
+}
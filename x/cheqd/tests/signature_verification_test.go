@@ -1,10 +1,19 @@
 package tests
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil/base58"
 	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"reflect"
 	"testing"
 )
@@ -134,3 +143,247 @@ func TestDIDDocVerificationMethodDeleted(t *testing.T) {
 	require.NotEqual(t, len(aliceDid.VerificationMethod), len(receivedDid.VerificationMethod))
 	require.True(t, reflect.DeepEqual(aliceDid.VerificationMethod[0], receivedDid.VerificationMethod[0]))
 }
+
+func TestSecp256k1VerificationMethods(t *testing.T) {
+	cases := []struct {
+		name   string
+		vmType string
+		sign   func(privKey *btcec.PrivateKey, message []byte) []byte
+		errMsg string
+	}{
+		{
+			name:   "EcdsaSecp256k1VerificationKey2019 works",
+			vmType: "EcdsaSecp256k1VerificationKey2019",
+			sign: func(privKey *btcec.PrivateKey, message []byte) []byte {
+				signature, _ := secp256k1.PrivKey(privKey.Serialize()).Sign(message)
+				return signature
+			},
+		},
+		{
+			name:   "EcdsaSecp256k1RecoveryMethod2020 works with recoverable signature",
+			vmType: "EcdsaSecp256k1RecoveryMethod2020",
+			sign: func(privKey *btcec.PrivateKey, message []byte) []byte {
+				hash := sha256.Sum256(message)
+				compact, _ := btcec.SignCompact(btcec.S256(), privKey, hash[:], true)
+				// V || R || S -> R || S || V
+				return append(compact[1:], compact[0]-27-4)
+			},
+		},
+		{
+			name:   "EcdsaSecp256k1RecoveryMethod2020 works with plain signature",
+			vmType: "EcdsaSecp256k1RecoveryMethod2020",
+			sign: func(privKey *btcec.PrivateKey, message []byte) []byte {
+				signature, _ := secp256k1.PrivKey(privKey.Serialize()).Sign(message)
+				return signature
+			},
+		},
+		{
+			name:   "Signature of another message does not work",
+			vmType: "EcdsaSecp256k1VerificationKey2019",
+			sign: func(privKey *btcec.PrivateKey, message []byte) []byte {
+				signature, _ := secp256k1.PrivKey(privKey.Serialize()).Sign([]byte("another"))
+				return signature
+			},
+			errMsg: "did:cheqd:test:alice: invalid signature detected",
+		},
+		{
+			name:   "Recoverable signature of another message does not work",
+			vmType: "EcdsaSecp256k1RecoveryMethod2020",
+			sign: func(privKey *btcec.PrivateKey, message []byte) []byte {
+				hash := sha256.Sum256([]byte("another"))
+				compact, _ := btcec.SignCompact(btcec.S256(), privKey, hash[:], true)
+				return append(compact[1:], compact[0]-27-4)
+			},
+			errMsg: "did:cheqd:test:alice: invalid signature detected",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+
+			privKey, _ := btcec.NewPrivateKey(btcec.S256())
+			payload := &v1.MsgCreateDidPayload{
+				Id:             AliceDID,
+				Authentication: []string{AliceKey1},
+				VerificationMethod: []*v1.VerificationMethod{
+					{
+						Id:                 AliceKey1,
+						Type:               tc.vmType,
+						Controller:         AliceDID,
+						PublicKeyMultibase: "z" + base58.Encode(privKey.PubKey().SerializeCompressed()),
+					},
+				},
+			}
+
+			signature := tc.sign(privKey, payload.GetSignBytes())
+			_, err := setup.Handler(setup.Ctx, v1.NewMsgCreateDid(payload, []*v1.SignInfo{
+				{
+					VerificationMethodId: AliceKey1,
+					Signature:            base64.StdEncoding.EncodeToString(signature),
+				},
+			}))
+
+			if tc.errMsg == "" {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestEd25519InvalidPublicKeyLength(t *testing.T) {
+	setup := Setup()
+
+	_, privKey, _ := ed25519.GenerateKey(rand.Reader)
+	payload := &v1.MsgCreateDidPayload{
+		Id:             AliceDID,
+		Authentication: []string{AliceKey1},
+		VerificationMethod: []*v1.VerificationMethod{
+			{
+				Id:                 AliceKey1,
+				Type:               "Ed25519VerificationKey2020",
+				Controller:         AliceDID,
+				PublicKeyMultibase: "z" + base58.Encode([]byte("short")),
+			},
+		},
+	}
+
+	_, err := setup.SendCreateDid(payload, map[string]ed25519.PrivateKey{AliceKey1: privKey})
+	require.Error(t, err)
+	require.Equal(t, "verification method 'did:cheqd:test:alice#key-1' ed25519 public key must be 32 bytes long: invalid public key: invalid signature detected", err.Error())
+}
+
+func TestJsonWebKey2020VerificationMethods(t *testing.T) {
+	b64 := base64.RawURLEncoding.EncodeToString
+
+	ed25519PubKey, ed25519PrivKey, _ := ed25519.GenerateKey(rand.Reader)
+	p256PrivKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	secp256k1PrivKey, _ := btcec.NewPrivateKey(btcec.S256())
+	rsaPrivKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	cases := []struct {
+		name   string
+		jwk    []*v1.KeyValuePair
+		sign   func(message []byte) []byte
+		errMsg string
+	}{
+		{
+			name: "Ed25519 works",
+			jwk: []*v1.KeyValuePair{
+				{Key: "kty", Value: "OKP"},
+				{Key: "crv", Value: "Ed25519"},
+				{Key: "x", Value: b64(ed25519PubKey)},
+			},
+			sign: func(message []byte) []byte {
+				return ed25519.Sign(ed25519PrivKey, message)
+			},
+		},
+		{
+			name: "P-256 works",
+			jwk: []*v1.KeyValuePair{
+				{Key: "kty", Value: "EC"},
+				{Key: "crv", Value: "P-256"},
+				{Key: "x", Value: b64(p256PrivKey.X.Bytes())},
+				{Key: "y", Value: b64(p256PrivKey.Y.Bytes())},
+			},
+			sign: func(message []byte) []byte {
+				hash := sha256.Sum256(message)
+				r, s, _ := ecdsa.Sign(rand.Reader, p256PrivKey, hash[:])
+				signature := make([]byte, 64)
+				r.FillBytes(signature[:32])
+				s.FillBytes(signature[32:])
+				return signature
+			},
+		},
+		{
+			name: "secp256k1 works",
+			jwk: []*v1.KeyValuePair{
+				{Key: "kty", Value: "EC"},
+				{Key: "crv", Value: "secp256k1"},
+				{Key: "x", Value: b64(secp256k1PrivKey.X.Bytes())},
+				{Key: "y", Value: b64(secp256k1PrivKey.Y.Bytes())},
+			},
+			sign: func(message []byte) []byte {
+				signature, _ := secp256k1.PrivKey(secp256k1PrivKey.Serialize()).Sign(message)
+				return signature
+			},
+		},
+		{
+			name: "RSA works",
+			jwk: []*v1.KeyValuePair{
+				{Key: "kty", Value: "RSA"},
+				{Key: "n", Value: b64(rsaPrivKey.N.Bytes())},
+				{Key: "e", Value: "AQAB"},
+			},
+			sign: func(message []byte) []byte {
+				hash := sha256.Sum256(message)
+				signature, _ := rsa.SignPKCS1v15(rand.Reader, rsaPrivKey, crypto.SHA256, hash[:])
+				return signature
+			},
+		},
+		{
+			name: "RSA PSS works",
+			jwk: []*v1.KeyValuePair{
+				{Key: "kty", Value: "RSA"},
+				{Key: "alg", Value: "PS256"},
+				{Key: "n", Value: b64(rsaPrivKey.N.Bytes())},
+				{Key: "e", Value: "AQAB"},
+			},
+			sign: func(message []byte) []byte {
+				hash := sha256.Sum256(message)
+				signature, _ := rsa.SignPSS(rand.Reader, rsaPrivKey, crypto.SHA256, hash[:], nil)
+				return signature
+			},
+		},
+		{
+			name: "Signature by another key does not work",
+			jwk: []*v1.KeyValuePair{
+				{Key: "kty", Value: "EC"},
+				{Key: "crv", Value: "P-256"},
+				{Key: "x", Value: b64(p256PrivKey.X.Bytes())},
+				{Key: "y", Value: b64(p256PrivKey.Y.Bytes())},
+			},
+			sign: func(message []byte) []byte {
+				return ed25519.Sign(ed25519PrivKey, message)
+			},
+			errMsg: "did:cheqd:test:alice: invalid signature detected",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+
+			payload := &v1.MsgCreateDidPayload{
+				Id:             AliceDID,
+				Authentication: []string{AliceKey1},
+				VerificationMethod: []*v1.VerificationMethod{
+					{
+						Id:           AliceKey1,
+						Type:         "JsonWebKey2020",
+						Controller:   AliceDID,
+						PublicKeyJwk: tc.jwk,
+					},
+				},
+			}
+
+			signature := tc.sign(payload.GetSignBytes())
+			_, err := setup.Handler(setup.Ctx, v1.NewMsgCreateDid(payload, []*v1.SignInfo{
+				{
+					VerificationMethodId: AliceKey1,
+					Signature:            base64.StdEncoding.EncodeToString(signature),
+				},
+			}))
+
+			if tc.errMsg == "" {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
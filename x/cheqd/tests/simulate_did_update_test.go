@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerySimulateDidUpdate(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+	charlieKeys, _, _ := setup.InitDid(CharlieDID)
+
+	controlled := "did:cheqd:test:controlled"
+	createMsg := &v1.MsgCreateDidPayload{
+		Id:         controlled,
+		Controller: []string{AliceDID, BobDID},
+	}
+
+	_, err := setup.SendCreateDid(createMsg, ConcatKeys(ConcatKeys(map[string]ed25519.PrivateKey{}, aliceKeys), bobKeys))
+	require.Nil(t, err)
+
+	state, _ := setup.Keeper.GetDid(&setup.Ctx, controlled)
+
+	// Bob is removed from the controllers, so he has to sign too
+	updateMsg := setup.CreateToUpdateDid(createMsg)
+	updateMsg.Controller = []string{AliceDID}
+	updateMsg.VersionId = state.Metadata.VersionId
+
+	res := simulateDidUpdate(t, setup, setup.WrapUpdateRequest(updateMsg, aliceKeys))
+	require.Equal(t, []string{AliceDID, BobDID}, res.RequiredSigners)
+	require.Equal(t, []*v1.SignatureVerification{{VerificationMethodId: AliceKey1, Valid: true}}, res.Signatures)
+	require.Contains(t, res.Error, "signature did:cheqd:test:bob not found")
+
+	// Nothing is written
+	after, _ := setup.Keeper.GetDid(&setup.Ctx, controlled)
+	require.Equal(t, state.Metadata.VersionId, after.Metadata.VersionId)
+
+	// Signatures of DIDs which don't have to sign are reported, but don't fail the update
+	keys := ConcatKeys(ConcatKeys(ConcatKeys(map[string]ed25519.PrivateKey{}, aliceKeys), bobKeys), charlieKeys)
+	res = simulateDidUpdate(t, setup, setup.WrapUpdateRequest(updateMsg, keys))
+	require.Empty(t, res.Error)
+	require.Len(t, res.Signatures, 3)
+
+	for _, signature := range res.Signatures {
+		if signature.VerificationMethodId == CharlieKey1 {
+			require.False(t, signature.Valid)
+			require.Contains(t, signature.Error, "did:cheqd:test:charlie is not a required signer")
+		} else {
+			require.True(t, signature.Valid)
+		}
+	}
+
+	updateMsg.VersionId = "wrong"
+	res = simulateDidUpdate(t, setup, setup.WrapUpdateRequest(updateMsg, keys))
+	require.Contains(t, res.Error, v1.ErrUnexpectedDidVersion.Error())
+	require.Equal(t, []string{AliceDID, BobDID}, res.RequiredSigners)
+}
+
+func TestQuerySimulateDidUpdate_NotFound(t *testing.T) {
+	setup := Setup()
+
+	keyPair := GenerateKeyPair()
+	updateMsg := setup.CreateToUpdateDid(setup.CreateDid(keyPair.PublicKey, BobDID))
+
+	res := simulateDidUpdate(t, setup, setup.WrapUpdateRequest(updateMsg, map[string]ed25519.PrivateKey{BobKey1: keyPair.PrivateKey}))
+	require.Empty(t, res.RequiredSigners)
+	require.False(t, res.Signatures[0].Valid)
+	require.Contains(t, res.Error, "key did:cheqd:test:bob doesn't exist")
+
+	_, err := setup.QueryClient().SimulateDidUpdate(sdk.WrapSDKContext(setup.Ctx), &v1.QuerySimulateDidUpdateRequest{})
+	require.Error(t, err)
+}
+
+func TestQuerySimulateDidUpdate_Limits(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	state, _ := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	did, _ := state.GetDid()
+
+	updateMsg := setup.CreateToUpdateDid(&v1.MsgCreateDidPayload{Id: did.Id, VerificationMethod: did.VerificationMethod, Authentication: did.Authentication})
+	updateMsg.VersionId = state.Metadata.VersionId
+	msg := setup.WrapUpdateRequest(updateMsg, aliceKeys)
+
+	signatures := make([]*v1.SignInfo, keeper.MaxSimulatedSignatures+1)
+	for i := range signatures {
+		signatures[i] = msg.Signatures[0]
+	}
+
+	_, err := setup.QueryClient().SimulateDidUpdate(sdk.WrapSDKContext(setup.Ctx), &v1.QuerySimulateDidUpdateRequest{
+		Payload:    msg.Payload,
+		Signatures: signatures,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "too many signatures")
+
+	params := setup.Keeper.GetParams(setup.Ctx)
+	params.MaxDidDocSize = uint64(msg.Payload.Size() - 1)
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	_, err = setup.QueryClient().SimulateDidUpdate(sdk.WrapSDKContext(setup.Ctx), &v1.QuerySimulateDidUpdateRequest{
+		Payload:    msg.Payload,
+		Signatures: msg.Signatures,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "payload is too large")
+}
+
+func simulateDidUpdate(t *testing.T, setup TestSetup, msg *v1.MsgUpdateDid) *v1.QuerySimulateDidUpdateResponse {
+	res, err := setup.QueryClient().SimulateDidUpdate(sdk.WrapSDKContext(setup.Ctx), &v1.QuerySimulateDidUpdateRequest{
+		Payload:    msg.Payload,
+		Signatures: msg.Signatures,
+	})
+	require.Nil(t, err)
+
+	return res
+}
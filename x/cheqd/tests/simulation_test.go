@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/simulation"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+)
+
+func newSimulationCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	v1.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func TestRandomizedGenState(t *testing.T) {
+	cdc := newSimulationCodec()
+
+	simState := module.SimulationState{
+		AppParams:    simtypes.AppParams{simulation.GenesisDidCount: []byte("5")},
+		Cdc:          cdc,
+		Rand:         rand.New(rand.NewSource(1)),
+		GenState:     map[string]json.RawMessage{},
+		GenTimestamp: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var genesis v1.GenesisState
+	cdc.MustUnmarshalJSON(simState.GenState[v1.ModuleName], &genesis)
+	require.Nil(t, genesis.Validate())
+	require.Len(t, genesis.DidList, 5)
+
+	// Genesis DIDs are controlled by the keys derived from their verification method ids
+	keys := simulation.NewDidKeys()
+	for _, state := range genesis.DidList {
+		did, err := state.GetDid()
+		require.Nil(t, err)
+
+		_, found := keys.Get(did.VerificationMethod[0])
+		require.True(t, found)
+	}
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := newSimulationCodec()
+	decoder := simulation.NewDecodeStore(cdc)
+
+	stateValue, err := v1.NewStateValue(&v1.Did{Id: AliceDID}, &v1.Metadata{VersionId: "1"})
+	require.Nil(t, err)
+
+	bz := cdc.MustMarshal(stateValue)
+	didPair := kv.Pair{Key: append(v1.KeyPrefix(v1.DidKey), []byte(AliceDID)...), Value: bz}
+	require.Equal(t, fmt.Sprintf("%v\n%v", *stateValue, *stateValue), decoder(didPair, didPair))
+
+	countPair := kv.Pair{Key: v1.KeyPrefix(v1.DidCountKey), Value: []byte{1}}
+	require.Equal(t, "01\n01", decoder(countPair, countPair))
+}
@@ -0,0 +1,250 @@
+package tests
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"encoding/base64"
+	"github.com/cheqd/cheqd-node/x/cheqd"
+	"github.com/cheqd/cheqd-node/x/cheqd/types/v1"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CreateStatusList(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *v1.MsgCreateStatusListPayload
+		keys   map[string]ed25519.PrivateKey
+		errMsg string
+	}{
+		{
+			valid:  false,
+			name:   "Unknown owner",
+			msg:    v1.NewMsgCreateStatusListPayload(StatusListDID, v1.StatusPurposeRevocation, v1.MinStatusListLength, []string{"did:cheqd:test:unknown"}),
+			keys:   aliceKeys,
+			errMsg: "did:cheqd:test:unknown: DID Doc not found",
+		},
+		{
+			valid:  false,
+			name:   "Not owner signature",
+			msg:    v1.NewMsgCreateStatusListPayload(StatusListDID, v1.StatusPurposeRevocation, v1.MinStatusListLength, []string{AliceDID}),
+			keys:   bobKeys,
+			errMsg: "signature did:cheqd:test:alice not found: invalid signature detected",
+		},
+		{
+			valid: true,
+			name:  "Valid status list",
+			msg:   v1.NewMsgCreateStatusListPayload(StatusListDID, v1.StatusPurposeRevocation, v1.MinStatusListLength, []string{AliceDID}),
+			keys:  aliceKeys,
+		},
+		{
+			valid:  false,
+			name:   "Status list already exists",
+			msg:    v1.NewMsgCreateStatusListPayload(StatusListDID, v1.StatusPurposeSuspension, v1.MinStatusListLength, []string{AliceDID}),
+			keys:   aliceKeys,
+			errMsg: "status list did:cheqd:test:status-list already exists: resource exists",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			statusList, err := setup.SendCreateStatusList(tc.msg, tc.keys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, tc.msg.Length, statusList.Length)
+				require.Equal(t, make([]byte, tc.msg.Length/8), statusList.Bitstring)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestHandler_UpdateStatusList(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	bobKeys, _, _ := setup.InitDid(BobDID)
+
+	revocationList := "did:cheqd:test:revocation-list"
+	suspensionList := "did:cheqd:test:suspension-list"
+
+	_, err := setup.SendCreateStatusList(v1.NewMsgCreateStatusListPayload(revocationList, v1.StatusPurposeRevocation, v1.MinStatusListLength, []string{AliceDID}), aliceKeys)
+	require.Nil(t, err)
+	_, err = setup.SendCreateStatusList(v1.NewMsgCreateStatusListPayload(suspensionList, v1.StatusPurposeSuspension, v1.MinStatusListLength, []string{AliceDID}), aliceKeys)
+	require.Nil(t, err)
+
+	cases := []struct {
+		valid    bool
+		name     string
+		msg      *v1.MsgUpdateStatusListPayload
+		keys     map[string]ed25519.PrivateKey
+		expected map[uint64]bool
+		errMsg   string
+	}{
+		{
+			valid:  false,
+			name:   "Unknown status list",
+			msg:    v1.NewMsgUpdateStatusListPayload("did:cheqd:test:unknown", []uint64{1}, nil, "1"),
+			keys:   aliceKeys,
+			errMsg: "did:cheqd:test:unknown: resource not found",
+		},
+		{
+			valid:  false,
+			name:   "Not owner signature",
+			msg:    v1.NewMsgUpdateStatusListPayload(revocationList, []uint64{1}, nil, ""),
+			keys:   bobKeys,
+			errMsg: "signature did:cheqd:test:alice not found: invalid signature detected",
+		},
+		{
+			valid:  false,
+			name:   "Index exceeds length",
+			msg:    v1.NewMsgUpdateStatusListPayload(revocationList, []uint64{v1.MinStatusListLength}, nil, ""),
+			keys:   aliceKeys,
+			errMsg: "Index 131072 exceeds Length 131072: bad request",
+		},
+		{
+			valid:  false,
+			name:   "Unexpected version",
+			msg:    v1.NewMsgUpdateStatusListPayload(revocationList, []uint64{1}, nil, "unknown"),
+			keys:   aliceKeys,
+			errMsg: "Got version unknown: unexpected status list version",
+		},
+		{
+			valid:    true,
+			name:     "Revoke credentials",
+			msg:      v1.NewMsgUpdateStatusListPayload(revocationList, []uint64{1, 10}, nil, ""),
+			keys:     aliceKeys,
+			expected: map[uint64]bool{0: false, 1: true, 10: true},
+		},
+		{
+			valid:  false,
+			name:   "Reinstate revoked credential",
+			msg:    v1.NewMsgUpdateStatusListPayload(revocationList, nil, []uint64{1}, ""),
+			keys:   aliceKeys,
+			errMsg: "Revoked credentials can't be reinstated: bad request",
+		},
+		{
+			valid:    true,
+			name:     "Suspend credentials",
+			msg:      v1.NewMsgUpdateStatusListPayload(suspensionList, []uint64{2, 3}, nil, ""),
+			keys:     aliceKeys,
+			expected: map[uint64]bool{2: true, 3: true},
+		},
+		{
+			valid:    true,
+			name:     "Reinstate suspended credential",
+			msg:      v1.NewMsgUpdateStatusListPayload(suspensionList, []uint64{4}, []uint64{2}, ""),
+			keys:     aliceKeys,
+			expected: map[uint64]bool{2: false, 3: true, 4: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			statusList, err := setup.SendUpdateStatusList(tc.msg, tc.keys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				for index, value := range tc.expected {
+					require.Equal(t, value, statusList.GetBit(index))
+				}
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestStatusListPages(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	_, err := setup.SendCreateStatusList(v1.NewMsgCreateStatusListPayload(StatusListDID, v1.StatusPurposeSuspension, v1.MinStatusListLength, []string{AliceDID}), aliceKeys)
+	require.Nil(t, err)
+
+	// The status list is stored without the bitstring
+	state, err := setup.Keeper.GetStatusListStateValue(&setup.Ctx, StatusListDID)
+	require.Nil(t, err)
+	header, err := state.GetStatusList()
+	require.Nil(t, err)
+	require.Empty(t, header.Bitstring)
+
+	lastPage := uint64(v1.MinStatusListLength/v1.StatusListPageLength - 1)
+	require.Len(t, setup.Keeper.GetStatusListPage(setup.Ctx, StatusListDID, lastPage), v1.StatusListPageSize)
+	require.Nil(t, setup.Keeper.GetStatusListPage(setup.Ctx, StatusListDID, lastPage+1))
+
+	// Only the pages with changed bits are written
+	set := []uint64{1, v1.StatusListPageLength + 2, v1.MinStatusListLength - 1}
+	statusList, err := setup.SendUpdateStatusList(v1.NewMsgUpdateStatusListPayload(StatusListDID, set, nil, ""), aliceKeys)
+	require.Nil(t, err)
+	require.Len(t, statusList.Bitstring, v1.MinStatusListLength/8)
+
+	for _, index := range set {
+		require.True(t, statusList.GetBit(index))
+	}
+
+	require.Equal(t, byte(0x40), setup.Keeper.GetStatusListPage(setup.Ctx, StatusListDID, 0)[0])
+	require.Equal(t, byte(0x20), setup.Keeper.GetStatusListPage(setup.Ctx, StatusListDID, 1)[0])
+	require.Equal(t, byte(0x01), setup.Keeper.GetStatusListPage(setup.Ctx, StatusListDID, lastPage)[v1.StatusListPageSize-1])
+
+	// Genesis keeps the whole bitstring
+	genesis := cheqd.ExportGenesis(setup.Ctx, setup.Keeper)
+	require.Len(t, genesis.StatusListList, 1)
+	require.Nil(t, genesis.Validate())
+
+	imported := Setup()
+	cheqd.InitGenesis(imported.Ctx, imported.Keeper, *genesis)
+
+	importedState, err := imported.Keeper.GetStatusList(&imported.Ctx, StatusListDID)
+	require.Nil(t, err)
+	importedList, err := importedState.GetStatusList()
+	require.Nil(t, err)
+	require.Equal(t, statusList.Bitstring, importedList.Bitstring)
+}
+
+func TestQueryStatusList(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, _ := setup.InitDid(AliceDID)
+	_, err := setup.SendCreateStatusList(v1.NewMsgCreateStatusListPayload(StatusListDID, v1.StatusPurposeRevocation, v1.MinStatusListLength, []string{AliceDID}), aliceKeys)
+	require.Nil(t, err)
+	_, err = setup.SendUpdateStatusList(v1.NewMsgUpdateStatusListPayload(StatusListDID, []uint64{0, 42}, nil, ""), aliceKeys)
+	require.Nil(t, err)
+
+	response, err := setup.Keeper.StatusList(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetStatusListRequest{Id: StatusListDID})
+	require.Nil(t, err)
+	require.Equal(t, v1.StatusPurposeRevocation, response.StatusList.StatusPurpose)
+	require.Empty(t, response.StatusList.Bitstring)
+
+	require.True(t, strings.HasPrefix(response.EncodedList, v1.StatusListEncodingPrefix))
+
+	compressed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(response.EncodedList, v1.StatusListEncodingPrefix))
+	require.Nil(t, err)
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.Nil(t, err)
+
+	bitstring, err := ioutil.ReadAll(reader)
+	require.Nil(t, err)
+	require.Len(t, bitstring, v1.MinStatusListLength/8)
+	require.Equal(t, byte(0x80), bitstring[0])
+	require.Equal(t, byte(0x20), bitstring[5])
+
+	_, err = setup.Keeper.StatusList(sdk.WrapSDKContext(setup.Ctx), &v1.QueryGetStatusListRequest{Id: "did:cheqd:test:unknown"})
+	require.Error(t, err)
+}
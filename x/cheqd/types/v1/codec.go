@@ -11,6 +11,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	// this line is used by starport scaffolding # 2
 	cdc.RegisterConcrete(&MsgCreateDid{}, "cheqd/CreateDid", nil)
 	cdc.RegisterConcrete(&MsgUpdateDid{}, "cheqd/UpdateDid", nil)
+	cdc.RegisterConcrete(&MsgDeactivateDid{}, "cheqd/DeactivateDid", nil)
+	cdc.RegisterConcrete(&MsgCreateSchema{}, "cheqd/CreateSchema", nil)
+	cdc.RegisterConcrete(&MsgCreateCredDef{}, "cheqd/CreateCredDef", nil)
+	cdc.RegisterConcrete(&MsgCreateRevocRegDef{}, "cheqd/CreateRevocRegDef", nil)
+	cdc.RegisterConcrete(&MsgCreateRevocRegEntry{}, "cheqd/CreateRevocRegEntry", nil)
+	cdc.RegisterConcrete(&MsgCreateStatusList{}, "cheqd/CreateStatusList", nil)
+	cdc.RegisterConcrete(&MsgUpdateStatusList{}, "cheqd/UpdateStatusList", nil)
+	cdc.RegisterConcrete(&MsgCreateResource{}, "cheqd/CreateResource", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -18,10 +26,35 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateDid{},
 		&MsgUpdateDid{},
+		&MsgDeactivateDid{},
+		&MsgCreateSchema{},
+		&MsgCreateCredDef{},
+		&MsgCreateRevocRegDef{},
+		&MsgCreateRevocRegEntry{},
+		&MsgCreateStatusList{},
+		&MsgUpdateStatusList{},
+		&MsgCreateResource{},
 	)
 
 	registry.RegisterInterface(MessageCreateDid, (*IdentityMsg)(nil), &MsgCreateDidPayload{})
 	registry.RegisterInterface(MessageUpdateDid, (*IdentityMsg)(nil), &MsgUpdateDidPayload{})
+	registry.RegisterInterface(MessageDeactivateDid, (*IdentityMsg)(nil), &MsgDeactivateDidPayload{})
+	registry.RegisterInterface(MessageCreateSchema, (*IdentityMsg)(nil), &MsgCreateSchemaPayload{})
+	registry.RegisterInterface(MessageCreateCredDef, (*IdentityMsg)(nil), &MsgCreateCredDefPayload{})
+	registry.RegisterInterface(MessageCreateRevocRegDef, (*IdentityMsg)(nil), &MsgCreateRevocRegDefPayload{})
+	registry.RegisterInterface(MessageCreateRevocRegEntry, (*IdentityMsg)(nil), &MsgCreateRevocRegEntryPayload{})
+	registry.RegisterInterface(MessageCreateStatusList, (*IdentityMsg)(nil), &MsgCreateStatusListPayload{})
+	registry.RegisterInterface(MessageUpdateStatusList, (*IdentityMsg)(nil), &MsgUpdateStatusListPayload{})
+	registry.RegisterInterface(MessageCreateResource, (*IdentityMsg)(nil), &MsgCreateResourcePayload{})
+
+	registry.RegisterInterface(StateValueDataName, (*StateValueData)(nil),
+		&Did{},
+		&Schema{},
+		&CredDef{},
+		&RevocRegDef{},
+		&RevocRegEntry{},
+		&StatusList{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateValueDataRegistration(t *testing.T) {
+	registry := cdctypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+
+	require.ElementsMatch(t, []string{
+		StateValueDid, StateValueSchema, StateValueCredDef, StateValueRevocRegDef, StateValueRevocRegEntry, StateValueStatusList,
+	}, registry.ListImplementations(StateValueDataName))
+}
+
+func TestGenesisStateJSON(t *testing.T) {
+	registry := cdctypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	did, err := NewStateValue(&Did{Id: "did:cheqd:test:alice"}, &Metadata{VersionId: "1"})
+	require.Nil(t, err)
+
+	schema, err := NewStateValue(&Schema{Id: "did:cheqd:test:schema", Name: "name"}, &Metadata{VersionId: "2"})
+	require.Nil(t, err)
+
+	genesis := DefaultGenesis()
+	genesis.DidList = []*StateValue{did}
+	genesis.SchemaList = []*StateValue{schema}
+
+	bz, err := cdc.MarshalJSON(genesis)
+	require.Nil(t, err)
+
+	var decoded GenesisState
+	require.Nil(t, cdc.UnmarshalJSON(bz, &decoded))
+
+	decodedDid, err := decoded.DidList[0].GetDid()
+	require.Nil(t, err)
+	require.Equal(t, "did:cheqd:test:alice", decodedDid.Id)
+
+	decodedSchema, err := decoded.SchemaList[0].GetSchema()
+	require.Nil(t, err)
+	require.Equal(t, "name", decodedSchema.Name)
+
+	// Type URLs of other messages can't be resolved
+	err = cdc.UnmarshalJSON([]byte(`{"data":{"@type":"/cheqdid.cheqdnode.cheqd.v1.Unknown"}}`), &StateValue{})
+	require.EqualError(t, err, "unable to resolve type URL /cheqdid.cheqdnode.cheqd.v1.Unknown")
+}
@@ -0,0 +1,9 @@
+package v1
+
+const (
+	// CredDefSignatureType is the only supported credential signature type
+	CredDefSignatureType = "CL-Sig-Cred_def"
+
+	// DefaultCredDefTag is used when a credential definition is created without a tag
+	DefaultCredDefTag = "tag"
+)
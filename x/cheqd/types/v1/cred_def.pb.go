@@ -0,0 +1,814 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/cred_def.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CredDef struct {
+	Id            string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SchemaId      string        `protobuf:"bytes,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	SignatureType string        `protobuf:"bytes,3,opt,name=signature_type,json=signatureType,proto3" json:"signature_type,omitempty"`
+	Tag           string        `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Value         *CredDefValue `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Controller    []string      `protobuf:"bytes,6,rep,name=controller,proto3" json:"controller,omitempty"`
+}
+
+func (m *CredDef) Reset()         { *m = CredDef{} }
+func (m *CredDef) String() string { return proto.CompactTextString(m) }
+func (*CredDef) ProtoMessage()    {}
+func (*CredDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_028e0c0f5aea9d70, []int{0}
+}
+func (m *CredDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredDef.Merge(m, src)
+}
+func (m *CredDef) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredDef proto.InternalMessageInfo
+
+func (m *CredDef) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CredDef) GetSchemaId() string {
+	if m != nil {
+		return m.SchemaId
+	}
+	return ""
+}
+
+func (m *CredDef) GetSignatureType() string {
+	if m != nil {
+		return m.SignatureType
+	}
+	return ""
+}
+
+func (m *CredDef) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *CredDef) GetValue() *CredDefValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *CredDef) GetController() []string {
+	if m != nil {
+		return m.Controller
+	}
+	return nil
+}
+
+// CredDefValue holds the JSON encoded credential public keys
+type CredDefValue struct {
+	Primary    string `protobuf:"bytes,1,opt,name=primary,proto3" json:"primary,omitempty"`
+	Revocation string `protobuf:"bytes,2,opt,name=revocation,proto3" json:"revocation,omitempty"`
+}
+
+func (m *CredDefValue) Reset()         { *m = CredDefValue{} }
+func (m *CredDefValue) String() string { return proto.CompactTextString(m) }
+func (*CredDefValue) ProtoMessage()    {}
+func (*CredDefValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_028e0c0f5aea9d70, []int{1}
+}
+func (m *CredDefValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredDefValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredDefValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredDefValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredDefValue.Merge(m, src)
+}
+func (m *CredDefValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredDefValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredDefValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredDefValue proto.InternalMessageInfo
+
+func (m *CredDefValue) GetPrimary() string {
+	if m != nil {
+		return m.Primary
+	}
+	return ""
+}
+
+func (m *CredDefValue) GetRevocation() string {
+	if m != nil {
+		return m.Revocation
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CredDef)(nil), "cheqdid.cheqdnode.cheqd.v1.CredDef")
+	proto.RegisterType((*CredDefValue)(nil), "cheqdid.cheqdnode.cheqd.v1.CredDefValue")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/cred_def.proto", fileDescriptor_028e0c0f5aea9d70) }
+
+var fileDescriptor_028e0c0f5aea9d70 = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0xc7, 0xeb, 0xf6, 0x6b, 0xfb, 0xd5, 0x40, 0x85, 0xbc, 0x60, 0x81, 0x64, 0x55, 0x95, 0x90,
+	0x32, 0x80, 0xa3, 0xc2, 0xce, 0x00, 0x08, 0xc1, 0x5a, 0x21, 0x06, 0x96, 0xca, 0xb5, 0xaf, 0xad,
+	0xa5, 0x36, 0x0e, 0xae, 0x13, 0x91, 0xb7, 0xe0, 0xb1, 0xd8, 0xe8, 0xc8, 0x88, 0x92, 0x17, 0x41,
+	0x71, 0x02, 0xca, 0xc2, 0x62, 0x9f, 0x7f, 0x77, 0x67, 0xfd, 0xef, 0x7f, 0xf8, 0x48, 0xae, 0xe0,
+	0x45, 0x85, 0xe9, 0x24, 0x94, 0x16, 0xd4, 0x4c, 0xc1, 0x82, 0xc7, 0xd6, 0x38, 0x43, 0x8e, 0x7d,
+	0x42, 0x2b, 0xee, 0xef, 0xc8, 0x28, 0xa8, 0x22, 0x9e, 0x4e, 0xc6, 0x1f, 0x08, 0xf7, 0x6f, 0x2c,
+	0xa8, 0x5b, 0x58, 0x90, 0x21, 0x6e, 0x6b, 0x45, 0xd1, 0x08, 0x05, 0x83, 0x69, 0x5b, 0x2b, 0x72,
+	0x82, 0x07, 0x5b, 0xb9, 0x82, 0x8d, 0x98, 0x69, 0x45, 0xdb, 0x1e, 0xff, 0xaf, 0xc0, 0x83, 0x22,
+	0xa7, 0x78, 0xb8, 0xd5, 0xcb, 0x48, 0xb8, 0xc4, 0xc2, 0xcc, 0x65, 0x31, 0xd0, 0x8e, 0xaf, 0x38,
+	0xf8, 0xa5, 0x8f, 0x59, 0x0c, 0xe4, 0x10, 0x77, 0x9c, 0x58, 0xd2, 0x7f, 0x3e, 0x57, 0x86, 0xe4,
+	0x0a, 0x77, 0x53, 0xb1, 0x4e, 0x80, 0x76, 0x47, 0x28, 0xd8, 0xbb, 0x08, 0xf8, 0xdf, 0xea, 0x78,
+	0xad, 0xec, 0xa9, 0xac, 0x9f, 0x56, 0x6d, 0x84, 0x61, 0x2c, 0x4d, 0xe4, 0xac, 0x59, 0xaf, 0xc1,
+	0xd2, 0xde, 0xa8, 0x13, 0x0c, 0xa6, 0x0d, 0x32, 0xbe, 0xc7, 0xfb, 0xcd, 0x36, 0x42, 0x71, 0x3f,
+	0xb6, 0x7a, 0x23, 0x6c, 0x56, 0x8f, 0xf6, 0xf3, 0x2c, 0x7f, 0xb2, 0x90, 0x1a, 0x29, 0x9c, 0x36,
+	0x51, 0x3d, 0x60, 0x83, 0x5c, 0xdf, 0xbd, 0xe7, 0x0c, 0xed, 0x72, 0x86, 0xbe, 0x72, 0x86, 0xde,
+	0x0a, 0xd6, 0xda, 0x15, 0xac, 0xf5, 0x59, 0xb0, 0xd6, 0xf3, 0xd9, 0x52, 0xbb, 0x55, 0x32, 0xe7,
+	0xd2, 0x6c, 0xc2, 0xca, 0x75, 0x7f, 0x9e, 0x97, 0xea, 0xc3, 0xd7, 0x1a, 0x95, 0xa6, 0x6c, 0xc3,
+	0x74, 0x32, 0xef, 0xf9, 0x35, 0x5c, 0x7e, 0x0f, 0x00, 0x3f, 0xd2, 0x96, 0x11, 0xa1, 0x01, 0x00,
+	0x00,
+}
+
+func (m *CredDef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Controller) > 0 {
+		for iNdEx := len(m.Controller) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Controller[iNdEx])
+			copy(dAtA[i:], m.Controller[iNdEx])
+			i = encodeVarintCredDef(dAtA, i, uint64(len(m.Controller[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCredDef(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintCredDef(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SignatureType) > 0 {
+		i -= len(m.SignatureType)
+		copy(dAtA[i:], m.SignatureType)
+		i = encodeVarintCredDef(dAtA, i, uint64(len(m.SignatureType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SchemaId) > 0 {
+		i -= len(m.SchemaId)
+		copy(dAtA[i:], m.SchemaId)
+		i = encodeVarintCredDef(dAtA, i, uint64(len(m.SchemaId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCredDef(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredDefValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredDefValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredDefValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revocation) > 0 {
+		i -= len(m.Revocation)
+		copy(dAtA[i:], m.Revocation)
+		i = encodeVarintCredDef(dAtA, i, uint64(len(m.Revocation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Primary) > 0 {
+		i -= len(m.Primary)
+		copy(dAtA[i:], m.Primary)
+		i = encodeVarintCredDef(dAtA, i, uint64(len(m.Primary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCredDef(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCredDef(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CredDef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCredDef(uint64(l))
+	}
+	l = len(m.SchemaId)
+	if l > 0 {
+		n += 1 + l + sovCredDef(uint64(l))
+	}
+	l = len(m.SignatureType)
+	if l > 0 {
+		n += 1 + l + sovCredDef(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovCredDef(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovCredDef(uint64(l))
+	}
+	if len(m.Controller) > 0 {
+		for _, s := range m.Controller {
+			l = len(s)
+			n += 1 + l + sovCredDef(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CredDefValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Primary)
+	if l > 0 {
+		n += 1 + l + sovCredDef(uint64(l))
+	}
+	l = len(m.Revocation)
+	if l > 0 {
+		n += 1 + l + sovCredDef(uint64(l))
+	}
+	return n
+}
+
+func sovCredDef(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCredDef(x uint64) (n int) {
+	return sovCredDef(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CredDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredDef
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &CredDefValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = append(m.Controller, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredDef(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredDefValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredDef
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredDefValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredDefValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Primary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredDef(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredDef
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCredDef(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCredDef
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCredDef
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCredDef
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCredDef
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCredDef
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCredDef        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCredDef          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCredDef = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,9 @@
 package v1
 
-import "github.com/multiformats/go-multibase"
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils/strings"
+	"github.com/multiformats/go-multibase"
+)
 
 func (v VerificationMethod) GetPublicKey() ([]byte, error) {
 	if len(v.PublicKeyMultibase) > 0 {
@@ -12,8 +15,75 @@ func (v VerificationMethod) GetPublicKey() ([]byte, error) {
 	}
 
 	if len(v.PublicKeyJwk) > 0 {
-		return nil, ErrInvalidPublicKey.Wrap("JWK format not supported")
+		return nil, ErrInvalidPublicKey.Wrapf("verification method '%s' public key is JWK, use GetJwkPublicKey", v.Id)
 	}
 
 	return nil, ErrInvalidPublicKey.Wrapf("verification method '%s' public key not found", v.Id)
 }
+
+// GetPublicKeyFingerprint returns `public_key_multibase` or the RFC 7638 thumbprint of `public_key_jwk`
+func (v VerificationMethod) GetPublicKeyFingerprint() (string, error) {
+	if len(v.PublicKeyMultibase) > 0 {
+		return v.PublicKeyMultibase, nil
+	}
+
+	if len(v.PublicKeyJwk) > 0 {
+		return GetJwkThumbprint(v.PublicKeyJwk)
+	}
+
+	return "", ErrInvalidPublicKey.Wrapf("verification method '%s' public key not found", v.Id)
+}
+
+// GetControllers returns the unique controllers of the DID Doc and its verification methods
+func (did *Did) GetControllers() []string {
+	var result []string
+
+	for _, controller := range did.Controller {
+		if !strings.Contains(result, controller) {
+			result = append(result, controller)
+		}
+	}
+
+	for _, vm := range did.VerificationMethod {
+		if !strings.Contains(result, vm.Controller) {
+			result = append(result, vm.Controller)
+		}
+	}
+
+	return result
+}
+
+// GetSigners returns the controllers that have to sign changes of the DID Doc.
+func (did *Did) GetSigners() []Signer {
+	if len(did.Controller) > 0 {
+		result := make([]Signer, len(did.Controller))
+
+		for i, controller := range did.Controller {
+			if controller == did.Id {
+				result[i] = Signer{
+					Signer:             controller,
+					Authentication:     did.Authentication,
+					VerificationMethod: did.VerificationMethod,
+				}
+			} else {
+				result[i] = Signer{
+					Signer: controller,
+				}
+			}
+		}
+
+		return result
+	}
+
+	if len(did.Authentication) > 0 {
+		return []Signer{
+			{
+				Signer:             did.Id,
+				Authentication:     did.Authentication,
+				VerificationMethod: did.VerificationMethod,
+			},
+		}
+	}
+
+	return []Signer{}
+}
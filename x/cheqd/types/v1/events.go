@@ -0,0 +1,7 @@
+package v1
+
+// The module also emits an untyped event of ModuleName type with the DID attribute for every DID operation,
+// so `cheqd.did='<did>'` queries match the typed events of the DID.
+const (
+	AttributeKeyDid = "did"
+)
@@ -0,0 +1,1313 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/events.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventDidCreated is emitted when a DID Doc is created
+type EventDidCreated struct {
+	Did         string   `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	VersionId   string   `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Controllers []string `protobuf:"bytes,3,rep,name=controllers,proto3" json:"controllers,omitempty"`
+	// Ids of the verification methods
+	VerificationMethods []string `protobuf:"bytes,4,rep,name=verification_methods,json=verificationMethods,proto3" json:"verification_methods,omitempty"`
+}
+
+func (m *EventDidCreated) Reset()         { *m = EventDidCreated{} }
+func (m *EventDidCreated) String() string { return proto.CompactTextString(m) }
+func (*EventDidCreated) ProtoMessage()    {}
+func (*EventDidCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b909cdb1821af1c6, []int{0}
+}
+func (m *EventDidCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDidCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDidCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDidCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDidCreated.Merge(m, src)
+}
+func (m *EventDidCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDidCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDidCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDidCreated proto.InternalMessageInfo
+
+func (m *EventDidCreated) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *EventDidCreated) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *EventDidCreated) GetControllers() []string {
+	if m != nil {
+		return m.Controllers
+	}
+	return nil
+}
+
+func (m *EventDidCreated) GetVerificationMethods() []string {
+	if m != nil {
+		return m.VerificationMethods
+	}
+	return nil
+}
+
+// EventDidUpdated is emitted when a DID Doc is updated.
+// It lists the controllers and the ids of the verification methods changed by the update.
+type EventDidUpdated struct {
+	Did                        string   `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	VersionId                  string   `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	PreviousVersionId          string   `protobuf:"bytes,3,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+	AddedControllers           []string `protobuf:"bytes,4,rep,name=added_controllers,json=addedControllers,proto3" json:"added_controllers,omitempty"`
+	RemovedControllers         []string `protobuf:"bytes,5,rep,name=removed_controllers,json=removedControllers,proto3" json:"removed_controllers,omitempty"`
+	AddedVerificationMethods   []string `protobuf:"bytes,6,rep,name=added_verification_methods,json=addedVerificationMethods,proto3" json:"added_verification_methods,omitempty"`
+	ChangedVerificationMethods []string `protobuf:"bytes,7,rep,name=changed_verification_methods,json=changedVerificationMethods,proto3" json:"changed_verification_methods,omitempty"`
+	RemovedVerificationMethods []string `protobuf:"bytes,8,rep,name=removed_verification_methods,json=removedVerificationMethods,proto3" json:"removed_verification_methods,omitempty"`
+}
+
+func (m *EventDidUpdated) Reset()         { *m = EventDidUpdated{} }
+func (m *EventDidUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventDidUpdated) ProtoMessage()    {}
+func (*EventDidUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b909cdb1821af1c6, []int{1}
+}
+func (m *EventDidUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDidUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDidUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDidUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDidUpdated.Merge(m, src)
+}
+func (m *EventDidUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDidUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDidUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDidUpdated proto.InternalMessageInfo
+
+func (m *EventDidUpdated) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *EventDidUpdated) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *EventDidUpdated) GetPreviousVersionId() string {
+	if m != nil {
+		return m.PreviousVersionId
+	}
+	return ""
+}
+
+func (m *EventDidUpdated) GetAddedControllers() []string {
+	if m != nil {
+		return m.AddedControllers
+	}
+	return nil
+}
+
+func (m *EventDidUpdated) GetRemovedControllers() []string {
+	if m != nil {
+		return m.RemovedControllers
+	}
+	return nil
+}
+
+func (m *EventDidUpdated) GetAddedVerificationMethods() []string {
+	if m != nil {
+		return m.AddedVerificationMethods
+	}
+	return nil
+}
+
+func (m *EventDidUpdated) GetChangedVerificationMethods() []string {
+	if m != nil {
+		return m.ChangedVerificationMethods
+	}
+	return nil
+}
+
+func (m *EventDidUpdated) GetRemovedVerificationMethods() []string {
+	if m != nil {
+		return m.RemovedVerificationMethods
+	}
+	return nil
+}
+
+// EventDidDeactivated is emitted when a DID Doc is deactivated
+type EventDidDeactivated struct {
+	Did               string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	VersionId         string `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	PreviousVersionId string `protobuf:"bytes,3,opt,name=previous_version_id,json=previousVersionId,proto3" json:"previous_version_id,omitempty"`
+}
+
+func (m *EventDidDeactivated) Reset()         { *m = EventDidDeactivated{} }
+func (m *EventDidDeactivated) String() string { return proto.CompactTextString(m) }
+func (*EventDidDeactivated) ProtoMessage()    {}
+func (*EventDidDeactivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b909cdb1821af1c6, []int{2}
+}
+func (m *EventDidDeactivated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDidDeactivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDidDeactivated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDidDeactivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDidDeactivated.Merge(m, src)
+}
+func (m *EventDidDeactivated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDidDeactivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDidDeactivated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDidDeactivated proto.InternalMessageInfo
+
+func (m *EventDidDeactivated) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *EventDidDeactivated) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *EventDidDeactivated) GetPreviousVersionId() string {
+	if m != nil {
+		return m.PreviousVersionId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventDidCreated)(nil), "cheqdid.cheqdnode.cheqd.v1.EventDidCreated")
+	proto.RegisterType((*EventDidUpdated)(nil), "cheqdid.cheqdnode.cheqd.v1.EventDidUpdated")
+	proto.RegisterType((*EventDidDeactivated)(nil), "cheqdid.cheqdnode.cheqd.v1.EventDidDeactivated")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/events.proto", fileDescriptor_b909cdb1821af1c6) }
+
+var fileDescriptor_b909cdb1821af1c6 = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0x9b, 0x9b, 0x7b, 0x7b, 0x6f, 0x7d, 0x07, 0x5a, 0x07, 0xa4, 0xa8, 0x82, 0xa8, 0xea,
+	0x54, 0x09, 0x48, 0x14, 0xb1, 0x32, 0x20, 0x5a, 0x90, 0x18, 0x58, 0x2a, 0xd1, 0x81, 0xa5, 0x4a,
+	0x73, 0x0e, 0x8d, 0xa5, 0x36, 0x0e, 0x8e, 0x6b, 0xc1, 0x5b, 0x20, 0x16, 0x5e, 0x89, 0xb1, 0x23,
+	0x23, 0x6a, 0x5f, 0x04, 0xc5, 0x49, 0x21, 0x82, 0x76, 0x61, 0x60, 0x49, 0xac, 0xf3, 0x7f, 0xe7,
+	0xd7, 0x6f, 0xfb, 0x98, 0xec, 0x84, 0x11, 0xde, 0x82, 0xa7, 0x7c, 0x0f, 0x15, 0xc6, 0x32, 0x75,
+	0x13, 0xc1, 0x25, 0xa7, 0x4d, 0x5d, 0x66, 0xe0, 0xea, 0x7f, 0xcc, 0x01, 0xf3, 0x95, 0xab, 0xfc,
+	0xf6, 0x93, 0x41, 0xb6, 0xce, 0x32, 0xb8, 0xc7, 0xa0, 0x2b, 0x30, 0x90, 0x08, 0xb4, 0x4e, 0x4c,
+	0x60, 0x60, 0x1b, 0x2d, 0xa3, 0x53, 0xeb, 0x67, 0x4b, 0xba, 0x47, 0x88, 0x42, 0x91, 0x32, 0x1e,
+	0x0f, 0x19, 0xd8, 0xbf, 0xb4, 0x50, 0x2b, 0x2a, 0x17, 0x40, 0x5b, 0xe4, 0x7f, 0xc8, 0x63, 0x29,
+	0xf8, 0x64, 0x82, 0x22, 0xb5, 0xcd, 0x96, 0xd9, 0xa9, 0xf5, 0xcb, 0x25, 0xea, 0x93, 0x6d, 0x85,
+	0x82, 0xdd, 0xb0, 0x30, 0x90, 0x99, 0xcb, 0x14, 0x65, 0xc4, 0x21, 0xb5, 0x7f, 0x6b, 0xd4, 0x2a,
+	0x6b, 0x97, 0xb9, 0xd4, 0x7e, 0x34, 0x3f, 0x92, 0x5d, 0x25, 0xf0, 0xbd, 0x64, 0x2e, 0xb1, 0x12,
+	0x81, 0x8a, 0xf1, 0x59, 0x3a, 0x2c, 0x71, 0xa6, 0xe6, 0x1a, 0x2b, 0x69, 0xf0, 0xce, 0xef, 0x93,
+	0x46, 0x00, 0x80, 0x30, 0x2c, 0xef, 0x27, 0x0f, 0x59, 0xd7, 0x42, 0xb7, 0xb4, 0x29, 0x8f, 0x58,
+	0x02, 0xa7, 0x5c, 0x7d, 0xc2, 0xff, 0x68, 0x9c, 0x16, 0x52, 0xb9, 0xe1, 0x98, 0x34, 0x73, 0xf7,
+	0xb5, 0x67, 0x51, 0xd5, 0x7d, 0xb6, 0x26, 0x06, 0x5f, 0x0f, 0x84, 0x9e, 0x90, 0xdd, 0x30, 0x0a,
+	0xe2, 0xf1, 0xa6, 0xfe, 0xbf, 0xba, 0xbf, 0x59, 0x30, 0x1b, 0x1c, 0x56, 0x81, 0xd7, 0x3a, 0xfc,
+	0xcb, 0x1d, 0x0a, 0x66, 0x8d, 0x43, 0x5b, 0x11, 0x6b, 0x75, 0x27, 0x3d, 0x0c, 0x42, 0xc9, 0xd4,
+	0x8f, 0xdc, 0xcb, 0xe9, 0xf9, 0xf3, 0xc2, 0x31, 0xe6, 0x0b, 0xc7, 0x78, 0x5d, 0x38, 0xc6, 0xc3,
+	0xd2, 0xa9, 0xcc, 0x97, 0x4e, 0xe5, 0x65, 0xe9, 0x54, 0xae, 0x0f, 0xc6, 0x4c, 0x46, 0xb3, 0x91,
+	0x1b, 0xf2, 0xa9, 0x97, 0x8f, 0xbf, 0xfe, 0x1e, 0x66, 0x63, 0xee, 0xdd, 0x15, 0x25, 0x79, 0x9f,
+	0x60, 0xea, 0x29, 0x7f, 0x54, 0xd5, 0x2f, 0xe2, 0xe8, 0x6d, 0x00, 0xdd, 0x53, 0xac, 0xeb, 0x2a,
+	0x03, 0x00, 0x00,
+}
+
+func (m *EventDidCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDidCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDidCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationMethods) > 0 {
+		for iNdEx := len(m.VerificationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationMethods[iNdEx])
+			copy(dAtA[i:], m.VerificationMethods[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.VerificationMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Controllers) > 0 {
+		for iNdEx := len(m.Controllers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Controllers[iNdEx])
+			copy(dAtA[i:], m.Controllers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Controllers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDidUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDidUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDidUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedVerificationMethods) > 0 {
+		for iNdEx := len(m.RemovedVerificationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedVerificationMethods[iNdEx])
+			copy(dAtA[i:], m.RemovedVerificationMethods[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.RemovedVerificationMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChangedVerificationMethods) > 0 {
+		for iNdEx := len(m.ChangedVerificationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedVerificationMethods[iNdEx])
+			copy(dAtA[i:], m.ChangedVerificationMethods[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ChangedVerificationMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AddedVerificationMethods) > 0 {
+		for iNdEx := len(m.AddedVerificationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedVerificationMethods[iNdEx])
+			copy(dAtA[i:], m.AddedVerificationMethods[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AddedVerificationMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RemovedControllers) > 0 {
+		for iNdEx := len(m.RemovedControllers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedControllers[iNdEx])
+			copy(dAtA[i:], m.RemovedControllers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.RemovedControllers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddedControllers) > 0 {
+		for iNdEx := len(m.AddedControllers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedControllers[iNdEx])
+			copy(dAtA[i:], m.AddedControllers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AddedControllers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreviousVersionId) > 0 {
+		i -= len(m.PreviousVersionId)
+		copy(dAtA[i:], m.PreviousVersionId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousVersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDidDeactivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDidDeactivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDidDeactivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousVersionId) > 0 {
+		i -= len(m.PreviousVersionId)
+		copy(dAtA[i:], m.PreviousVersionId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousVersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventDidCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Controllers) > 0 {
+		for _, s := range m.Controllers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.VerificationMethods) > 0 {
+		for _, s := range m.VerificationMethods {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDidUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousVersionId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AddedControllers) > 0 {
+		for _, s := range m.AddedControllers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedControllers) > 0 {
+		for _, s := range m.RemovedControllers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.AddedVerificationMethods) > 0 {
+		for _, s := range m.AddedVerificationMethods {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ChangedVerificationMethods) > 0 {
+		for _, s := range m.ChangedVerificationMethods {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedVerificationMethods) > 0 {
+		for _, s := range m.RemovedVerificationMethods {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDidDeactivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousVersionId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventDidCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDidCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDidCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controllers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controllers = append(m.Controllers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethods = append(m.VerificationMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDidUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDidUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDidUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedControllers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedControllers = append(m.AddedControllers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedControllers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedControllers = append(m.RemovedControllers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedVerificationMethods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedVerificationMethods = append(m.AddedVerificationMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedVerificationMethods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedVerificationMethods = append(m.ChangedVerificationMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedVerificationMethods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedVerificationMethods = append(m.RemovedVerificationMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDidDeactivated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDidDeactivated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDidDeactivated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
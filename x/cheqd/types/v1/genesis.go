@@ -6,15 +6,32 @@ const DidNamespace = "testnet"
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+
 	return &GenesisState{
-		DidList:      []*StateValue{},
-		DidNamespace: DidNamespace,
+		DidList:           []*StateValue{},
+		DidVersionList:    []*StateValue{},
+		SchemaList:        []*StateValue{},
+		CredDefList:       []*StateValue{},
+		RevocRegDefList:   []*StateValue{},
+		RevocRegEntryList: []*StateValue{},
+		StatusListList:    []*StateValue{},
+		ResourceList:      []*Resource{},
+		DidNamespace:      DidNamespace,
+		Params:            &params,
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Genesis files without params get the default ones
+	if gs.Params != nil {
+		if err := gs.Params.Validate(); err != nil {
+			return err
+		}
+	}
+
 	didIdMap := make(map[string]bool)
 
 	for _, elem := range gs.DidList {
@@ -30,5 +47,126 @@ func (gs GenesisState) Validate() error {
 		didIdMap[did.Id] = true
 	}
 
+	for _, elem := range gs.DidVersionList {
+		did, err := elem.GetDid()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := didIdMap[did.Id]; !ok {
+			return fmt.Errorf("did version for unknown did %s", did.Id)
+		}
+	}
+
+	schemaIdMap := make(map[string]bool)
+
+	for _, elem := range gs.SchemaList {
+		schema, err := elem.GetSchema()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := schemaIdMap[schema.Id]; ok {
+			return fmt.Errorf("duplicated id for schema")
+		}
+
+		schemaIdMap[schema.Id] = true
+	}
+
+	credDefIdMap := make(map[string]bool)
+
+	for _, elem := range gs.CredDefList {
+		credDef, err := elem.GetCredDef()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := credDefIdMap[credDef.Id]; ok {
+			return fmt.Errorf("duplicated id for cred def")
+		}
+
+		if _, ok := schemaIdMap[credDef.SchemaId]; !ok {
+			return fmt.Errorf("cred def %s for unknown schema %s", credDef.Id, credDef.SchemaId)
+		}
+
+		credDefIdMap[credDef.Id] = true
+	}
+
+	revocRegDefIdMap := make(map[string]bool)
+
+	for _, elem := range gs.RevocRegDefList {
+		revocRegDef, err := elem.GetRevocRegDef()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := revocRegDefIdMap[revocRegDef.Id]; ok {
+			return fmt.Errorf("duplicated id for revoc reg def")
+		}
+
+		if _, ok := credDefIdMap[revocRegDef.CredDefId]; !ok {
+			return fmt.Errorf("revoc reg def %s for unknown cred def %s", revocRegDef.Id, revocRegDef.CredDefId)
+		}
+
+		revocRegDefIdMap[revocRegDef.Id] = true
+	}
+
+	for _, elem := range gs.RevocRegEntryList {
+		revocRegEntry, err := elem.GetRevocRegEntry()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := revocRegDefIdMap[revocRegEntry.RevocRegDefId]; !ok {
+			return fmt.Errorf("revoc reg entry for unknown revoc reg def %s", revocRegEntry.RevocRegDefId)
+		}
+	}
+
+	statusListIdMap := make(map[string]bool)
+
+	for _, elem := range gs.StatusListList {
+		statusList, err := elem.GetStatusList()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := statusListIdMap[statusList.Id]; ok {
+			return fmt.Errorf("duplicated id for status list")
+		}
+
+		if uint64(len(statusList.Bitstring))*8 != statusList.Length {
+			return fmt.Errorf("bitstring of status list %s doesn't match its length", statusList.Id)
+		}
+
+		statusListIdMap[statusList.Id] = true
+	}
+
+	resourceIdMap := make(map[string]bool)
+
+	for _, elem := range gs.ResourceList {
+		if elem.Header == nil {
+			return fmt.Errorf("resource header is required")
+		}
+
+		resourceId := elem.Header.CollectionId + "/" + elem.Header.Id
+		if _, ok := resourceIdMap[resourceId]; ok {
+			return fmt.Errorf("duplicated id for resource")
+		}
+
+		if _, ok := didIdMap[elem.Header.CollectionId]; !ok {
+			return fmt.Errorf("resource for unknown did %s", elem.Header.CollectionId)
+		}
+
+		if len(elem.Header.Name) > MaxResourceNameLength || len(elem.Header.ResourceType) > MaxResourceTypeLength {
+			return fmt.Errorf("name or type of resource %s is too long", resourceId)
+		}
+
+		if elem.Header.Checksum != GetChecksum(elem.Data) {
+			return fmt.Errorf("checksum of resource %s doesn't match its data", resourceId)
+		}
+
+		resourceIdMap[resourceId] = true
+	}
+
 	return nil
 }
@@ -24,8 +24,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the capability module's genesis state.
 type GenesisState struct {
-	DidNamespace string        `protobuf:"bytes,1,opt,name=did_namespace,json=didNamespace,proto3" json:"did_namespace,omitempty"`
-	DidList      []*StateValue `protobuf:"bytes,2,rep,name=didList,proto3" json:"didList,omitempty"`
+	DidNamespace      string        `protobuf:"bytes,1,opt,name=did_namespace,json=didNamespace,proto3" json:"did_namespace,omitempty"`
+	DidList           []*StateValue `protobuf:"bytes,2,rep,name=didList,proto3" json:"didList,omitempty"`
+	DidVersionList    []*StateValue `protobuf:"bytes,3,rep,name=didVersionList,proto3" json:"didVersionList,omitempty"`
+	SchemaList        []*StateValue `protobuf:"bytes,4,rep,name=schemaList,proto3" json:"schemaList,omitempty"`
+	CredDefList       []*StateValue `protobuf:"bytes,5,rep,name=credDefList,proto3" json:"credDefList,omitempty"`
+	RevocRegDefList   []*StateValue `protobuf:"bytes,6,rep,name=revocRegDefList,proto3" json:"revocRegDefList,omitempty"`
+	RevocRegEntryList []*StateValue `protobuf:"bytes,7,rep,name=revocRegEntryList,proto3" json:"revocRegEntryList,omitempty"`
+	StatusListList    []*StateValue `protobuf:"bytes,8,rep,name=statusListList,proto3" json:"statusListList,omitempty"`
+	ResourceList      []*Resource   `protobuf:"bytes,9,rep,name=resourceList,proto3" json:"resourceList,omitempty"`
+	Params            *Params       `protobuf:"bytes,10,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -75,6 +83,62 @@ func (m *GenesisState) GetDidList() []*StateValue {
 	return nil
 }
 
+func (m *GenesisState) GetDidVersionList() []*StateValue {
+	if m != nil {
+		return m.DidVersionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSchemaList() []*StateValue {
+	if m != nil {
+		return m.SchemaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCredDefList() []*StateValue {
+	if m != nil {
+		return m.CredDefList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevocRegDefList() []*StateValue {
+	if m != nil {
+		return m.RevocRegDefList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevocRegEntryList() []*StateValue {
+	if m != nil {
+		return m.RevocRegEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStatusListList() []*StateValue {
+	if m != nil {
+		return m.StatusListList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetResourceList() []*Resource {
+	if m != nil {
+		return m.ResourceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cheqdid.cheqdnode.cheqd.v1.GenesisState")
 }
@@ -82,21 +146,32 @@ func init() {
 func init() { proto.RegisterFile("cheqd/v1/genesis.proto", fileDescriptor_85a78c6000d41e7d) }
 
 var fileDescriptor_85a78c6000d41e7d = []byte{
-	// 216 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x48, 0x2d,
-	0x4c, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x02, 0x8b, 0x67, 0xa6, 0xe8, 0x81, 0xe9, 0xbc, 0xfc, 0x94, 0x54, 0x08,
-	0x4b, 0xaf, 0xcc, 0x50, 0x4a, 0x12, 0xae, 0xa7, 0xb8, 0x24, 0xb1, 0x24, 0x35, 0x2c, 0x31, 0xa7,
-	0x34, 0x15, 0xa2, 0x4d, 0xa9, 0x94, 0x8b, 0xc7, 0x1d, 0x62, 0x4e, 0x30, 0x48, 0x4a, 0x48, 0x99,
-	0x8b, 0x37, 0x25, 0x33, 0x25, 0x3e, 0x2f, 0x31, 0x37, 0xb5, 0xb8, 0x20, 0x31, 0x39, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x88, 0x27, 0x25, 0x33, 0xc5, 0x0f, 0x26, 0x26, 0xe4, 0xc0, 0xc5,
-	0x9e, 0x92, 0x99, 0xe2, 0x93, 0x59, 0x5c, 0x22, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa6,
-	0x87, 0xdb, 0x76, 0xbd, 0x60, 0xb8, 0x9d, 0x41, 0x30, 0x6d, 0x4e, 0x6e, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0x0f, 0x71, 0x36, 0x98, 0xd4, 0x05, 0x99, 0xa9, 0x5f, 0x01, 0x15, 0x2a, 0xa9, 0x2c,
-	0x48, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x62, 0x03, 0xfb, 0xc2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x25, 0xe7, 0x91, 0xa5, 0x16, 0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x4e, 0xea, 0x40,
+	0x14, 0xc7, 0xe9, 0xe5, 0x02, 0x97, 0x81, 0x7b, 0x6f, 0x9c, 0x44, 0x45, 0x16, 0x0d, 0x41, 0x63,
+	0x58, 0x68, 0x1b, 0x70, 0xe7, 0xca, 0x18, 0x45, 0x16, 0x86, 0x90, 0x62, 0x58, 0xb8, 0x31, 0xa5,
+	0x73, 0x84, 0x49, 0xec, 0x87, 0x33, 0xd3, 0x46, 0xde, 0xc2, 0xc7, 0x72, 0xc9, 0xc6, 0xc4, 0xa5,
+	0x81, 0x17, 0x31, 0x9d, 0x7e, 0x04, 0x30, 0x92, 0x74, 0xd3, 0x8f, 0xff, 0x99, 0xdf, 0x6f, 0x26,
+	0x99, 0x73, 0xd0, 0x9e, 0x35, 0x85, 0x67, 0xa2, 0x07, 0x6d, 0x7d, 0x02, 0x0e, 0x70, 0xca, 0x35,
+	0x8f, 0xb9, 0xc2, 0xc5, 0x75, 0x99, 0x53, 0xa2, 0xc9, 0xb7, 0xe3, 0x12, 0x88, 0xbe, 0xb4, 0xa0,
+	0x5d, 0x3f, 0x48, 0x19, 0x2e, 0x4c, 0x01, 0x23, 0xf3, 0xc9, 0x87, 0x08, 0xab, 0xef, 0xa7, 0x25,
+	0x06, 0xdc, 0xf5, 0x99, 0x95, 0x14, 0x76, 0xd3, 0x82, 0x67, 0x32, 0xd3, 0x8e, 0xb7, 0x69, 0xbe,
+	0x17, 0x50, 0xf5, 0x26, 0xda, 0x78, 0x18, 0xba, 0xf0, 0x21, 0xfa, 0x4b, 0x28, 0x79, 0x70, 0x4c,
+	0x1b, 0xb8, 0x67, 0x5a, 0x50, 0x53, 0x1a, 0x4a, 0xab, 0x6c, 0x54, 0x09, 0x25, 0xfd, 0x24, 0xc3,
+	0x17, 0xa8, 0x44, 0x28, 0xb9, 0xa5, 0x5c, 0xd4, 0x7e, 0x35, 0xf2, 0xad, 0x4a, 0xe7, 0x58, 0xfb,
+	0xf9, 0xb8, 0xda, 0x30, 0x3d, 0xa4, 0x91, 0x60, 0xb8, 0x8f, 0xfe, 0x11, 0x4a, 0x46, 0xc0, 0x38,
+	0x75, 0x1d, 0x29, 0xca, 0x67, 0x12, 0x6d, 0xd0, 0xb8, 0x8b, 0x10, 0xb7, 0xa6, 0x60, 0x9b, 0xd2,
+	0xf5, 0x3b, 0x93, 0x6b, 0x85, 0xc4, 0x3d, 0x54, 0xb1, 0x18, 0x90, 0x2b, 0x78, 0x94, 0xa2, 0x42,
+	0x26, 0xd1, 0x2a, 0x8a, 0x07, 0xe8, 0x3f, 0x83, 0xc0, 0xb5, 0x0c, 0x98, 0x24, 0xb6, 0x62, 0x26,
+	0xdb, 0x26, 0x8e, 0xef, 0xd0, 0x4e, 0x12, 0x5d, 0x3b, 0x82, 0xcd, 0xa4, 0xb3, 0x94, 0xc9, 0xf9,
+	0x5d, 0x10, 0xde, 0x44, 0xd8, 0x45, 0x3e, 0x0f, 0xff, 0xa4, 0xf2, 0x4f, 0xb6, 0x9b, 0x58, 0xa7,
+	0x71, 0x0f, 0x55, 0x93, 0xd6, 0x93, 0xb6, 0xb2, 0xb4, 0x1d, 0x6d, 0xb3, 0x19, 0xf1, 0x7a, 0x63,
+	0x8d, 0xc4, 0xe7, 0xa8, 0x18, 0xf5, 0x6a, 0x0d, 0x35, 0x94, 0x56, 0xa5, 0xd3, 0xdc, 0xe6, 0x18,
+	0xc8, 0x95, 0x46, 0x4c, 0x5c, 0x76, 0xdf, 0x16, 0xaa, 0x32, 0x5f, 0xa8, 0xca, 0xe7, 0x42, 0x55,
+	0x5e, 0x97, 0x6a, 0x6e, 0xbe, 0x54, 0x73, 0x1f, 0x4b, 0x35, 0x77, 0x7f, 0x32, 0xa1, 0x62, 0xea,
+	0x8f, 0x35, 0xcb, 0xb5, 0xf5, 0x68, 0x26, 0xe4, 0xf3, 0x34, 0xd4, 0xe9, 0x2f, 0x71, 0x24, 0x66,
+	0x1e, 0x70, 0x3d, 0x68, 0x8f, 0x8b, 0x72, 0x4c, 0xce, 0xbe, 0x06, 0x00, 0x60, 0xe7, 0x4e, 0xe6,
+	0xa7, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +194,116 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ResourceList) > 0 {
+		for iNdEx := len(m.ResourceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.StatusListList) > 0 {
+		for iNdEx := len(m.StatusListList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StatusListList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RevocRegEntryList) > 0 {
+		for iNdEx := len(m.RevocRegEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevocRegEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RevocRegDefList) > 0 {
+		for iNdEx := len(m.RevocRegDefList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevocRegDefList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CredDefList) > 0 {
+		for iNdEx := len(m.CredDefList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredDefList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SchemaList) > 0 {
+		for iNdEx := len(m.SchemaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SchemaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DidVersionList) > 0 {
+		for iNdEx := len(m.DidVersionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidVersionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DidList) > 0 {
 		for iNdEx := len(m.DidList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -170,6 +355,52 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DidVersionList) > 0 {
+		for _, e := range m.DidVersionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SchemaList) > 0 {
+		for _, e := range m.SchemaList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CredDefList) > 0 {
+		for _, e := range m.CredDefList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevocRegDefList) > 0 {
+		for _, e := range m.RevocRegDefList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevocRegEntryList) > 0 {
+		for _, e := range m.RevocRegEntryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StatusListList) > 0 {
+		for _, e := range m.StatusListList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ResourceList) > 0 {
+		for _, e := range m.ResourceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -274,6 +505,280 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidVersionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidVersionList = append(m.DidVersionList, &StateValue{})
+			if err := m.DidVersionList[len(m.DidVersionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaList = append(m.SchemaList, &StateValue{})
+			if err := m.SchemaList[len(m.SchemaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredDefList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredDefList = append(m.CredDefList, &StateValue{})
+			if err := m.CredDefList[len(m.CredDefList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocRegDefList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocRegDefList = append(m.RevocRegDefList, &StateValue{})
+			if err := m.RevocRegDefList[len(m.RevocRegDefList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocRegEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocRegEntryList = append(m.RevocRegEntryList, &StateValue{})
+			if err := m.RevocRegEntryList[len(m.RevocRegEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusListList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusListList = append(m.StatusListList, &StateValue{})
+			if err := m.StatusListList[len(m.StatusListList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceList = append(m.ResourceList, &Resource{})
+			if err := m.ResourceList[len(m.ResourceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
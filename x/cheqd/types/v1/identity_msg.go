@@ -2,7 +2,7 @@ package v1
 
 type (
 	IdentityMsg interface {
-		Validate(namespace string) error
+		Validate(namespace string, params Params) error
 		GetSigners() []Signer
 		GetSignBytes() []byte
 	}
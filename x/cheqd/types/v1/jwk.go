@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+const (
+	JwkKeyTypeOKP = "OKP"
+	JwkKeyTypeEC  = "EC"
+	JwkKeyTypeRSA = "RSA"
+
+	JwkCurveEd25519   = "Ed25519"
+	JwkCurveP256      = "P-256"
+	JwkCurveSecp256k1 = "secp256k1"
+
+	JwkRsaMinModulusBits = 2048
+	JwkRsaMaxModulusBits = 4096
+	JwkRsaMaxExponent    = 1<<31 - 1
+)
+
+// GetJwk returns `public_key_jwk` members as a map
+func (v VerificationMethod) GetJwk() map[string]string {
+	result := make(map[string]string, len(v.PublicKeyJwk))
+	for _, pair := range v.PublicKeyJwk {
+		result[pair.Key] = pair.Value
+	}
+
+	return result
+}
+
+// GetJwkPublicKey parses `public_key_jwk` into ed25519.PublicKey, *ecdsa.PublicKey (P-256),
+// *btcec.PublicKey (secp256k1) or *rsa.PublicKey
+func (v VerificationMethod) GetJwkPublicKey() (crypto.PublicKey, error) {
+	if len(v.PublicKeyJwk) == 0 {
+		return nil, ErrInvalidPublicKey.Wrapf("verification method '%s' public key jwk not found", v.Id)
+	}
+
+	key, err := ParseJwk(v.PublicKeyJwk)
+	if err != nil {
+		return nil, ErrInvalidPublicKey.Wrapf("verification method '%s' public key jwk: %s", v.Id, err.Error())
+	}
+
+	return key, nil
+}
+
+// jwkThumbprintMembers are the required members of each key type, see RFC 7638 section 3.2
+var jwkThumbprintMembers = map[string][]string{
+	JwkKeyTypeOKP: {"crv", "kty", "x"},
+	JwkKeyTypeEC:  {"crv", "kty", "x", "y"},
+	JwkKeyTypeRSA: {"e", "kty", "n"},
+}
+
+// GetJwkThumbprint returns the base64url encoded SHA-256 JWK thumbprint defined by RFC 7638
+func GetJwkThumbprint(pairs []*KeyValuePair) (string, error) {
+	jwk := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		jwk[pair.Key] = pair.Value
+	}
+
+	members, found := jwkThumbprintMembers[jwk["kty"]]
+	if !found {
+		return "", ErrBadRequest.Wrapf("%s: unsupported key type", jwk["kty"])
+	}
+
+	required := make(map[string]string, len(members))
+	for _, member := range members {
+		if len(jwk[member]) == 0 {
+			return "", ErrBadRequestIsRequired.Wrap(member)
+		}
+
+		required[member] = jwk[member]
+	}
+
+	// Keys of maps are marshalled in lexicographic order without whitespaces
+	canonical, err := json.Marshal(required)
+	if err != nil {
+		return "", ErrBadRequest.Wrap(err.Error())
+	}
+
+	digest := sha256.Sum256(canonical)
+	return base64.RawURLEncoding.EncodeToString(digest[:]), nil
+}
+
+// ParseJwk validates the required JWK members and builds a public key from them
+func ParseJwk(pairs []*KeyValuePair) (crypto.PublicKey, error) {
+	jwk := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		if _, found := jwk[pair.Key]; found {
+			return nil, ErrBadRequest.Wrapf("`%s` member is duplicated", pair.Key)
+		}
+
+		jwk[pair.Key] = pair.Value
+	}
+
+	switch jwk["kty"] {
+	case JwkKeyTypeOKP:
+		return parseOkpJwk(jwk)
+	case JwkKeyTypeEC:
+		return parseEcJwk(jwk)
+	case JwkKeyTypeRSA:
+		return parseRsaJwk(jwk)
+	case "":
+		return nil, ErrBadRequestIsRequired.Wrap("kty")
+	default:
+		return nil, ErrBadRequest.Wrapf("%s: unsupported key type", jwk["kty"])
+	}
+}
+
+func parseOkpJwk(jwk map[string]string) (crypto.PublicKey, error) {
+	if jwk["crv"] != JwkCurveEd25519 {
+		return nil, ErrBadRequest.Wrapf("%s: unsupported OKP curve", jwk["crv"])
+	}
+
+	x, err := decodeJwkMember(jwk, "x")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(x) != ed25519.PublicKeySize {
+		return nil, ErrBadRequest.Wrapf("x: must be %d bytes long", ed25519.PublicKeySize)
+	}
+
+	return ed25519.PublicKey(x), nil
+}
+
+func parseEcJwk(jwk map[string]string) (crypto.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk["crv"] {
+	case JwkCurveP256:
+		curve = elliptic.P256()
+	case JwkCurveSecp256k1:
+		curve = btcec.S256()
+	default:
+		return nil, ErrBadRequest.Wrapf("%s: unsupported EC curve", jwk["crv"])
+	}
+
+	x, err := decodeJwkMember(jwk, "x")
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := decodeJwkMember(jwk, "y")
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	if !curve.IsOnCurve(pubKey.X, pubKey.Y) {
+		return nil, ErrBadRequest.Wrap("the point is not on the curve")
+	}
+
+	if curve == btcec.S256() {
+		return (*btcec.PublicKey)(&pubKey), nil
+	}
+
+	return &pubKey, nil
+}
+
+func parseRsaJwk(jwk map[string]string) (crypto.PublicKey, error) {
+	n, err := decodeJwkMember(jwk, "n")
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := decodeJwkMember(jwk, "e")
+	if err != nil {
+		return nil, err
+	}
+
+	// Short moduli are insecure and long ones make every signature check expensive
+	modulus := new(big.Int).SetBytes(n)
+	if modulus.BitLen() < JwkRsaMinModulusBits || modulus.BitLen() > JwkRsaMaxModulusBits {
+		return nil, ErrBadRequest.Wrapf("n: modulus must be between %d and %d bits long", JwkRsaMinModulusBits, JwkRsaMaxModulusBits)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > JwkRsaMaxExponent || exponent.Bit(0) == 0 {
+		return nil, ErrBadRequest.Wrapf("e: exponent must be an odd number between 3 and %d", JwkRsaMaxExponent)
+	}
+
+	return &rsa.PublicKey{N: modulus, E: int(exponent.Int64())}, nil
+}
+
+func decodeJwkMember(jwk map[string]string, name string) ([]byte, error) {
+	value, found := jwk[name]
+	if !found || len(value) == 0 {
+		return nil, ErrBadRequestIsRequired.Wrap(name)
+	}
+
+	bytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, ErrBadRequest.Wrapf("%s: must be base64url encoded", name)
+	}
+
+	return bytes, nil
+}
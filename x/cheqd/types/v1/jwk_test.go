@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+)
+
+func jwk(pairs ...string) []*KeyValuePair {
+	var result []*KeyValuePair
+	for i := 0; i < len(pairs); i += 2 {
+		result = append(result, &KeyValuePair{Key: pairs[i], Value: pairs[i+1]})
+	}
+
+	return result
+}
+
+// rsaModulus returns a base64url encoded modulus of the bit length
+func rsaModulus(bits int) string {
+	n := make([]byte, bits/8)
+	for i := range n {
+		n[i] = 0xff
+	}
+
+	return base64.RawURLEncoding.EncodeToString(n)
+}
+
+func TestParseJwk(t *testing.T) {
+	cases := []struct {
+		name     string
+		jwk      []*KeyValuePair
+		expected interface{}
+		errMsg   string
+	}{
+		{
+			name:     "Ed25519",
+			jwk:      jwk("kty", "OKP", "crv", "Ed25519", "x", "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"),
+			expected: ed25519.PublicKey{},
+		},
+		{
+			name:     "P-256",
+			jwk:      jwk("kty", "EC", "crv", "P-256", "x", "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU", "y", "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"),
+			expected: &ecdsa.PublicKey{},
+		},
+		{
+			name:     "secp256k1",
+			jwk:      jwk("kty", "EC", "crv", "secp256k1", "x", "Z4Y3NNOxv0J6tCgqOBFnHnaZhJF6LdulT7z8A-2D5_8", "y", "i5a2NtJoUKXkLm6q8nOEu9WOkso1Ag6FTUT6k_LMnGk"),
+			expected: &btcec.PublicKey{},
+		},
+		{
+			name:     "RSA",
+			jwk:      jwk("kty", "RSA", "n", "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw", "e", "AQAB"),
+			expected: &rsa.PublicKey{},
+		},
+		{name: "No kty", jwk: jwk("crv", "Ed25519"), errMsg: "kty: is required"},
+		{name: "Unknown kty", jwk: jwk("kty", "oct"), errMsg: "oct: unsupported key type: bad request"},
+		{name: "Duplicated member", jwk: jwk("kty", "OKP", "kty", "EC"), errMsg: "`kty` member is duplicated: bad request"},
+		{name: "Unknown OKP curve", jwk: jwk("kty", "OKP", "crv", "X25519", "x", "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"), errMsg: "X25519: unsupported OKP curve: bad request"},
+		{name: "OKP without x", jwk: jwk("kty", "OKP", "crv", "Ed25519"), errMsg: "x: is required"},
+		{name: "OKP with short x", jwk: jwk("kty", "OKP", "crv", "Ed25519", "x", "c2hvcnQ"), errMsg: "x: must be 32 bytes long: bad request"},
+		{name: "OKP with invalid x", jwk: jwk("kty", "OKP", "crv", "Ed25519", "x", "!!!"), errMsg: "x: must be base64url encoded: bad request"},
+		{name: "Unknown EC curve", jwk: jwk("kty", "EC", "crv", "P-384"), errMsg: "P-384: unsupported EC curve: bad request"},
+		{name: "EC without y", jwk: jwk("kty", "EC", "crv", "P-256", "x", "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU"), errMsg: "y: is required"},
+		{name: "EC point not on curve", jwk: jwk("kty", "EC", "crv", "P-256", "x", "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU", "y", "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU"), errMsg: "the point is not on the curve: bad request"},
+		{name: "RSA without e", jwk: jwk("kty", "RSA", "n", "AQAB"), errMsg: "e: is required"},
+		{name: "RSA without n", jwk: jwk("kty", "RSA", "e", "AQAB"), errMsg: "n: is required"},
+		{name: "RSA with short n", jwk: jwk("kty", "RSA", "n", rsaModulus(1024), "e", "AQAB"), errMsg: "n: modulus must be between 2048 and 4096 bits long: bad request"},
+		{name: "RSA with long n", jwk: jwk("kty", "RSA", "n", rsaModulus(8192), "e", "AQAB"), errMsg: "n: modulus must be between 2048 and 4096 bits long: bad request"},
+		{name: "RSA with 4096 bits n", jwk: jwk("kty", "RSA", "n", rsaModulus(4096), "e", "AQAB"), expected: &rsa.PublicKey{}},
+		{name: "RSA with even e", jwk: jwk("kty", "RSA", "n", rsaModulus(2048), "e", "AQAA"), errMsg: "e: exponent must be an odd number between 3 and 2147483647: bad request"},
+		{name: "RSA with e of 1", jwk: jwk("kty", "RSA", "n", rsaModulus(2048), "e", "AQ"), errMsg: "e: exponent must be an odd number between 3 and 2147483647: bad request"},
+		{name: "RSA with huge e", jwk: jwk("kty", "RSA", "n", rsaModulus(2048), "e", "AQAAAAE"), errMsg: "e: exponent must be an odd number between 3 and 2147483647: bad request"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := ParseJwk(tc.jwk)
+
+			if tc.errMsg == "" {
+				require.Nil(t, err)
+				require.IsType(t, tc.expected, key)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestGetJwkThumbprint(t *testing.T) {
+	// RFC 7638 section 3.1 example
+	rsaKey := jwk(
+		"kty", "RSA",
+		"n", "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		"e", "AQAB",
+		"alg", "RS256",
+		"kid", "2011-04-29",
+	)
+
+	thumbprint, err := GetJwkThumbprint(rsaKey)
+	require.Nil(t, err)
+	require.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", thumbprint)
+
+	// Member order doesn't change the thumbprint
+	okpKey := jwk("x", "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo", "crv", "Ed25519", "kty", "OKP")
+	reordered := jwk("kty", "OKP", "crv", "Ed25519", "x", "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo", "use", "sig")
+
+	first, err := GetJwkThumbprint(okpKey)
+	require.Nil(t, err)
+	second, err := GetJwkThumbprint(reordered)
+	require.Nil(t, err)
+	require.Equal(t, first, second)
+
+	_, err = GetJwkThumbprint(jwk("kty", "EC", "crv", "P-256", "x", "abc"))
+	require.EqualError(t, err, "y: is required")
+
+	_, err = GetJwkThumbprint(jwk("kty", "oct", "k", "abc"))
+	require.EqualError(t, err, "oct: unsupported key type: bad request")
+}
@@ -27,4 +27,41 @@ const (
 	DidCountKey = "did-count:"
 )
 
+const (
+	DidVersionKey      = "did-version:"
+	DidVersionIdKey    = "did-version-id:"
+	DidVersionCountKey = "did-version-count:"
+)
+
+const (
+	DidControllerKey = "did-controller:"
+	DidPublicKeyKey  = "did-public-key:"
+)
+
+const (
+	SchemaKey = "schema:"
+)
+
+const (
+	CredDefKey    = "cred-def:"
+	CredDefTagKey = "cred-def-tag:"
+)
+
+const (
+	RevocRegDefKey        = "revoc-reg-def:"
+	RevocRegEntryKey      = "revoc-reg-entry:"
+	RevocRegEntryCountKey = "revoc-reg-entry-count:"
+)
+
+const (
+	StatusListKey     = "status-list:"
+	StatusListPageKey = "status-list-page:"
+)
+
+const (
+	ResourceHeaderKey        = "resource-header:"
+	ResourceDataKey          = "resource-data:"
+	ResourceLatestVersionKey = "resource-latest-version:"
+)
+
 const DidNamespaceKey = "did-namespace:"
@@ -7,3 +7,29 @@ const (
 const (
 	MessageUpdateDid = "/cheqdid.cheqdnode.cheqd.v1.MsgUpdateDidPayload"
 )
+
+const (
+	MessageDeactivateDid = "/cheqdid.cheqdnode.cheqd.v1.MsgDeactivateDidPayload"
+)
+
+const (
+	MessageCreateSchema = "/cheqdid.cheqdnode.cheqd.v1.MsgCreateSchemaPayload"
+)
+
+const (
+	MessageCreateCredDef = "/cheqdid.cheqdnode.cheqd.v1.MsgCreateCredDefPayload"
+)
+
+const (
+	MessageCreateRevocRegDef   = "/cheqdid.cheqdnode.cheqd.v1.MsgCreateRevocRegDefPayload"
+	MessageCreateRevocRegEntry = "/cheqdid.cheqdnode.cheqd.v1.MsgCreateRevocRegEntryPayload"
+)
+
+const (
+	MessageCreateStatusList = "/cheqdid.cheqdnode.cheqd.v1.MsgCreateStatusListPayload"
+	MessageUpdateStatusList = "/cheqdid.cheqdnode.cheqd.v1.MsgUpdateStatusListPayload"
+)
+
+const (
+	MessageCreateResource = "/cheqdid.cheqdnode.cheqd.v1.MsgCreateResourcePayload"
+)
@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateCredDef{}
+
+func NewMsgCreateCredDef(payload *MsgCreateCredDefPayload, signatures []*SignInfo) *MsgCreateCredDef {
+	return &MsgCreateCredDef{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateCredDef) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateCredDef) Type() string {
+	return "MsgCreateCredDef"
+}
+
+func (msg *MsgCreateCredDef) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateCredDef) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateCredDef) ValidateBasic() error {
+	if msg.Payload == nil {
+		return ErrBadRequestIsRequired.Wrap("Payload")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Signatures")
+	}
+
+	return nil
+}
+
+var _ IdentityMsg = &MsgCreateCredDefPayload{}
+
+func NewMsgCreateCredDefPayload(
+	id string,
+	schemaId string,
+	signatureType string,
+	tag string,
+	value *CredDefValue,
+	controller []string,
+) *MsgCreateCredDefPayload {
+	return &MsgCreateCredDefPayload{
+		Id:            id,
+		SchemaId:      schemaId,
+		SignatureType: signatureType,
+		Tag:           tag,
+		Value:         value,
+		Controller:    controller,
+	}
+}
+
+// GetSigners returns no signers because the payload doesn't carry the issuer DID Docs.
+// The controllers of the stored issuer DID Docs have to sign the credential definition.
+func (msg *MsgCreateCredDefPayload) GetSigners() []Signer {
+	return []Signer{}
+}
+
+// GetTagOrDefault returns the credential definition tag or the default one if the tag isn't set
+func (msg *MsgCreateCredDefPayload) GetTagOrDefault() string {
+	if len(msg.Tag) == 0 {
+		return DefaultCredDefTag
+	}
+
+	return msg.Tag
+}
+
+func (msg *MsgCreateCredDefPayload) Validate(namespace string, _ Params) error {
+	if !utils.IsValidDid(namespace, msg.Id) {
+		return ErrBadRequestIsNotDid.Wrap("Id")
+	}
+
+	if !utils.IsValidDid(namespace, msg.SchemaId) {
+		return ErrBadRequestIsNotDid.Wrap("SchemaId")
+	}
+
+	if msg.SignatureType != CredDefSignatureType {
+		return ErrBadRequest.Wrapf("SignatureType should be %s", CredDefSignatureType)
+	}
+
+	if !utils.DidForbiddenSymbolsRegexp.MatchString(msg.GetTagOrDefault()) {
+		return ErrBadRequest.Wrap("Tag contains forbidden symbols")
+	}
+
+	if msg.Value == nil || len(msg.Value.Primary) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Value.Primary")
+	}
+
+	if len(msg.Controller) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Controller")
+	}
+
+	if notValid, i := utils.IsNotValidDIDArray(namespace, msg.Controller); notValid {
+		return ErrBadRequestIsNotDid.Wrapf("Controller item %s at position %d", msg.Controller[i], i)
+	}
+
+	return nil
+}
+
+func (msg *MsgCreateCredDefPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
@@ -83,6 +83,44 @@ func (msg *MsgUpdateDid) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgDeactivateDid{}
+
+func NewMsgDeactivateDid(payload *MsgDeactivateDidPayload, signatures []*SignInfo) *MsgDeactivateDid {
+	return &MsgDeactivateDid{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgDeactivateDid) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeactivateDid) Type() string {
+	return "MsgDeactivateDid"
+}
+
+func (msg *MsgDeactivateDid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgDeactivateDid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeactivateDid) ValidateBasic() error {
+	if msg.Payload == nil {
+		return ErrBadRequestIsRequired.Wrap("Payload")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Signatures")
+	}
+
+	return nil
+}
+
 func NewMsgCreateDidPayloadPayload(
 	context []string,
 	id string,
@@ -113,54 +151,32 @@ func NewMsgCreateDidPayloadPayload(
 
 var _ IdentityMsg = &MsgCreateDidPayload{}
 
+// GetSigners returns the controllers that have to sign the DID Doc described by the payload
 func (msg *MsgCreateDidPayload) GetSigners() []Signer {
-	if len(msg.Controller) > 0 {
-		result := make([]Signer, len(msg.Controller))
-
-		for i, controller := range msg.Controller {
-			if controller == msg.Id {
-				result[i] = Signer{
-					Signer:             controller,
-					Authentication:     msg.Authentication,
-					VerificationMethod: msg.VerificationMethod,
-				}
-			} else {
-				result[i] = Signer{
-					Signer: controller,
-				}
-			}
-		}
-
-		return result
-	}
-
-	if len(msg.Authentication) > 0 {
-		return []Signer{
-			{
-				Signer:             msg.Id,
-				Authentication:     msg.Authentication,
-				VerificationMethod: msg.VerificationMethod,
-			},
-		}
-	}
-
-	return []Signer{}
+	did := msg.ToDid()
+	return did.GetSigners()
 }
 
-func (msg *MsgCreateDidPayload) Validate(namespace string) error {
+// Validate validates the payload against the given module params
+func (msg *MsgCreateDidPayload) Validate(namespace string, params Params) error {
 	if !utils.IsValidDid(namespace, msg.Id) {
 		return ErrBadRequestIsNotDid.Wrap("Id")
 	}
 
+	did := msg.ToDid()
+	if err := params.ValidateDidDocLimits(&did); err != nil {
+		return err
+	}
+
 	if notValid, i := utils.IsNotValidDIDArray(namespace, msg.Controller); notValid {
 		return ErrBadRequestIsNotDid.Wrapf("Controller item %s at position %d", msg.Controller[i], i)
 	}
 
-	if err := ValidateVerificationMethods(namespace, msg.Id, msg.VerificationMethod); err != nil {
+	if err := ValidateVerificationMethods(namespace, params, msg.Id, msg.VerificationMethod); err != nil {
 		return err
 	}
 
-	if err := ValidateServices(namespace, msg.Id, msg.Service); err != nil {
+	if err := ValidateServices(namespace, params, msg.Id, msg.Service); err != nil {
 		return err
 	}
 
@@ -215,6 +231,23 @@ func (msg *MsgCreateDidPayload) GetSignBytes() []byte {
 	return ModuleCdc.MustMarshal(msg)
 }
 
+// ToDid returns the DID Doc the payload describes
+func (msg *MsgCreateDidPayload) ToDid() Did {
+	return Did{
+		Context:              msg.Context,
+		Id:                   msg.Id,
+		Controller:           msg.Controller,
+		VerificationMethod:   msg.VerificationMethod,
+		Authentication:       msg.Authentication,
+		AssertionMethod:      msg.AssertionMethod,
+		CapabilityInvocation: msg.CapabilityInvocation,
+		CapabilityDelegation: msg.CapabilityDelegation,
+		KeyAgreement:         msg.KeyAgreement,
+		AlsoKnownAs:          msg.AlsoKnownAs,
+		Service:              msg.Service,
+	}
+}
+
 var _ IdentityMsg = &MsgUpdateDidPayload{}
 
 func NewMsgUpdateDidPayloadPayload(
@@ -245,54 +278,32 @@ func NewMsgUpdateDidPayloadPayload(
 	}
 }
 
+// GetSigners returns the controllers that have to sign the DID Doc described by the payload
 func (msg *MsgUpdateDidPayload) GetSigners() []Signer {
-	if len(msg.Controller) > 0 {
-		result := make([]Signer, len(msg.Controller))
-
-		for i, controller := range msg.Controller {
-			if controller == msg.Id {
-				result[i] = Signer{
-					Signer:             controller,
-					Authentication:     msg.Authentication,
-					VerificationMethod: msg.VerificationMethod,
-				}
-			} else {
-				result[i] = Signer{
-					Signer: controller,
-				}
-			}
-		}
-
-		return result
-	}
-
-	if len(msg.Authentication) > 0 {
-		return []Signer{
-			{
-				Signer:             msg.Id,
-				Authentication:     msg.Authentication,
-				VerificationMethod: msg.VerificationMethod,
-			},
-		}
-	}
-
-	return []Signer{}
+	did := msg.ToDid()
+	return did.GetSigners()
 }
 
-func (msg *MsgUpdateDidPayload) Validate(namespace string) error {
+// Validate validates the payload against the given module params
+func (msg *MsgUpdateDidPayload) Validate(namespace string, params Params) error {
 	if !utils.IsValidDid(namespace, msg.Id) {
 		return ErrBadRequestIsNotDid.Wrap("Id")
 	}
 
+	did := msg.ToDid()
+	if err := params.ValidateDidDocLimits(&did); err != nil {
+		return err
+	}
+
 	if notValid, i := utils.IsNotValidDIDArray(namespace, msg.Controller); notValid {
 		return ErrBadRequestIsNotDid.Wrapf("Controller item %s at position %d", msg.Controller[i], i)
 	}
 
-	if err := ValidateVerificationMethods(namespace, msg.Id, msg.VerificationMethod); err != nil {
+	if err := ValidateVerificationMethods(namespace, params, msg.Id, msg.VerificationMethod); err != nil {
 		return err
 	}
 
-	if err := ValidateServices(namespace, msg.Id, msg.Service); err != nil {
+	if err := ValidateServices(namespace, params, msg.Id, msg.Service); err != nil {
 		return err
 	}
 
@@ -347,9 +358,57 @@ func (msg *MsgUpdateDidPayload) GetSignBytes() []byte {
 	return ModuleCdc.MustMarshal(msg)
 }
 
-func ValidateVerificationMethods(namespace string, did string, vms []*VerificationMethod) error {
+// ToDid returns the DID Doc the payload describes
+func (msg *MsgUpdateDidPayload) ToDid() Did {
+	return Did{
+		Context:              msg.Context,
+		Id:                   msg.Id,
+		Controller:           msg.Controller,
+		VerificationMethod:   msg.VerificationMethod,
+		Authentication:       msg.Authentication,
+		AssertionMethod:      msg.AssertionMethod,
+		CapabilityInvocation: msg.CapabilityInvocation,
+		CapabilityDelegation: msg.CapabilityDelegation,
+		KeyAgreement:         msg.KeyAgreement,
+		AlsoKnownAs:          msg.AlsoKnownAs,
+		Service:              msg.Service,
+	}
+}
+
+var _ IdentityMsg = &MsgDeactivateDidPayload{}
+
+func NewMsgDeactivateDidPayload(id string, versionId string) *MsgDeactivateDidPayload {
+	return &MsgDeactivateDidPayload{
+		Id:        id,
+		VersionId: versionId,
+	}
+}
+
+// GetSigners returns no signers because the payload doesn't carry the DID Doc.
+// The controllers of the stored DID Doc have to sign the deactivation.
+func (msg *MsgDeactivateDidPayload) GetSigners() []Signer {
+	return []Signer{}
+}
+
+func (msg *MsgDeactivateDidPayload) Validate(namespace string, _ Params) error {
+	if !utils.IsValidDid(namespace, msg.Id) {
+		return ErrBadRequestIsNotDid.Wrap("Id")
+	}
+
+	if len(msg.VersionId) == 0 {
+		return ErrBadRequestIsRequired.Wrap("VersionId")
+	}
+
+	return nil
+}
+
+func (msg *MsgDeactivateDidPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func ValidateVerificationMethods(namespace string, params Params, did string, vms []*VerificationMethod) error {
 	for i, vm := range vms {
-		if err := ValidateVerificationMethod(namespace, vm); err != nil {
+		if err := ValidateVerificationMethod(namespace, params, vm); err != nil {
 			return ErrBadRequestInvalidVerMethod.Wrap(sdkerrors.Wrapf(err, "index %d, value %s", i, vm.Id).Error())
 		}
 	}
@@ -367,7 +426,7 @@ func ValidateVerificationMethods(namespace string, did string, vms []*Verificati
 	return nil
 }
 
-func ValidateVerificationMethod(namespace string, vm *VerificationMethod) error {
+func ValidateVerificationMethod(namespace string, params Params, vm *VerificationMethod) error {
 	if !utils.IsFullDidFragment(namespace, vm.Id) {
 		return ErrBadRequestIsNotDidFragment.Wrap(vm.Id)
 	}
@@ -376,19 +435,15 @@ func ValidateVerificationMethod(namespace string, vm *VerificationMethod) error
 		return ErrBadRequest.Wrap("contains multiple verification material properties")
 	}
 
-	switch utils.GetVerificationMethodType(vm.Type) {
-	case utils.PublicKeyJwk:
-		if len(vm.PublicKeyJwk) == 0 {
-			return ErrBadRequest.Wrapf("%s: should contain `PublicKeyJwk` verification material property", vm.Type)
-		}
-	case utils.PublicKeyMultibase:
-		if len(vm.PublicKeyMultibase) == 0 {
-			return ErrBadRequest.Wrapf("%s: should contain `PublicKeyMultibase` verification material property", vm.Type)
-		}
-	default:
+	materials := params.GetVerificationMaterials(vm.Type)
+	if len(materials) == 0 {
 		return ErrBadRequest.Wrapf("%s: unsupported verification method type", vm.Type)
 	}
 
+	if !HasVerificationMaterial(vm, materials) {
+		return ErrBadRequest.Wrapf("%s: should contain `%s` verification material property", vm.Type, strings.Join(materials, "` or `"))
+	}
+
 	if len(vm.PublicKeyMultibase) == 0 && vm.PublicKeyJwk == nil {
 		return ErrBadRequest.Wrap("The verification method must contain either a PublicKeyMultibase or a PublicKeyJwk")
 	}
@@ -397,12 +452,35 @@ func ValidateVerificationMethod(namespace string, vm *VerificationMethod) error
 		return ErrBadRequestIsRequired.Wrap("Controller")
 	}
 
+	if len(vm.PublicKeyJwk) != 0 {
+		if _, err := ParseJwk(vm.PublicKeyJwk); err != nil {
+			return sdkerrors.Wrap(err, "PublicKeyJwk")
+		}
+	}
+
 	return nil
 }
 
-func ValidateServices(namespace string, did string, services []*Service) error {
+func HasVerificationMaterial(vm *VerificationMethod, materials []string) bool {
+	for _, material := range materials {
+		switch material {
+		case utils.PublicKeyJwk:
+			if len(vm.PublicKeyJwk) != 0 {
+				return true
+			}
+		case utils.PublicKeyMultibase:
+			if len(vm.PublicKeyMultibase) != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func ValidateServices(namespace string, params Params, did string, services []*Service) error {
 	for i, s := range services {
-		if err := ValidateService(namespace, s); err != nil {
+		if err := ValidateService(namespace, params, s); err != nil {
 			return ErrBadRequestInvalidService.Wrap(sdkerrors.Wrapf(err, "index %d, value %s", i, s.Id).Error())
 		}
 	}
@@ -420,12 +498,12 @@ func ValidateServices(namespace string, did string, services []*Service) error {
 	return nil
 }
 
-func ValidateService(namespace string, s *Service) error {
+func ValidateService(namespace string, params Params, s *Service) error {
 	if !utils.IsDidFragment(namespace, s.Id) {
 		return ErrBadRequestIsNotDidFragment.Wrap(s.Id)
 	}
 
-	if !utils.IsValidDidServiceType(s.Type) {
+	if !params.IsAllowedServiceType(s.Type) {
 		return ErrBadRequest.Wrapf("%s: unsupported service type", s.Type)
 	}
 
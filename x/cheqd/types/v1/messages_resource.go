@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"mime"
+)
+
+var _ sdk.Msg = &MsgCreateResource{}
+
+func NewMsgCreateResource(payload *MsgCreateResourcePayload, signatures []*SignInfo) *MsgCreateResource {
+	return &MsgCreateResource{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateResource) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateResource) Type() string {
+	return "MsgCreateResource"
+}
+
+func (msg *MsgCreateResource) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateResource) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateResource) ValidateBasic() error {
+	if msg.Payload == nil {
+		return ErrBadRequestIsRequired.Wrap("Payload")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Signatures")
+	}
+
+	return nil
+}
+
+var _ IdentityMsg = &MsgCreateResourcePayload{}
+
+func NewMsgCreateResourcePayload(
+	collectionId string,
+	id string,
+	name string,
+	resourceType string,
+	mediaType string,
+	data []byte,
+) *MsgCreateResourcePayload {
+	return &MsgCreateResourcePayload{
+		CollectionId: collectionId,
+		Id:           id,
+		Name:         name,
+		ResourceType: resourceType,
+		MediaType:    mediaType,
+		Data:         data,
+	}
+}
+
+// GetSigners returns no signers because the payload doesn't carry the collection DID Doc.
+// The controllers of the stored collection DID Doc have to sign the resource.
+func (msg *MsgCreateResourcePayload) GetSigners() []Signer {
+	return []Signer{}
+}
+
+func (msg *MsgCreateResourcePayload) Validate(namespace string, _ Params) error {
+	if !utils.IsValidDid(namespace, msg.CollectionId) {
+		return ErrBadRequestIsNotDid.Wrap("CollectionId")
+	}
+
+	if !utils.IsValidUUID(msg.Id) {
+		return ErrBadRequest.Wrap("Id should be UUID")
+	}
+
+	if len(msg.Name) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Name")
+	}
+
+	if len(msg.Name) > MaxResourceNameLength {
+		return ErrBadRequest.Wrapf("Name should be at most %d bytes", MaxResourceNameLength)
+	}
+
+	if len(msg.ResourceType) == 0 {
+		return ErrBadRequestIsRequired.Wrap("ResourceType")
+	}
+
+	if len(msg.ResourceType) > MaxResourceTypeLength {
+		return ErrBadRequest.Wrapf("ResourceType should be at most %d bytes", MaxResourceTypeLength)
+	}
+
+	if _, _, err := mime.ParseMediaType(msg.MediaType); err != nil {
+		return ErrBadRequest.Wrapf("MediaType is invalid: %s", err.Error())
+	}
+
+	if len(msg.Data) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Data")
+	}
+
+	if len(msg.Data) > MaxResourceSize {
+		return ErrBadRequest.Wrapf("Data should be at most %d bytes", MaxResourceSize)
+	}
+
+	return nil
+}
+
+func (msg *MsgCreateResourcePayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
@@ -0,0 +1,205 @@
+package v1
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateRevocRegDef{}
+
+func NewMsgCreateRevocRegDef(payload *MsgCreateRevocRegDefPayload, signatures []*SignInfo) *MsgCreateRevocRegDef {
+	return &MsgCreateRevocRegDef{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateRevocRegDef) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateRevocRegDef) Type() string {
+	return "MsgCreateRevocRegDef"
+}
+
+func (msg *MsgCreateRevocRegDef) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateRevocRegDef) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateRevocRegDef) ValidateBasic() error {
+	if msg.Payload == nil {
+		return ErrBadRequestIsRequired.Wrap("Payload")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Signatures")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgCreateRevocRegEntry{}
+
+func NewMsgCreateRevocRegEntry(payload *MsgCreateRevocRegEntryPayload, signatures []*SignInfo) *MsgCreateRevocRegEntry {
+	return &MsgCreateRevocRegEntry{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateRevocRegEntry) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateRevocRegEntry) Type() string {
+	return "MsgCreateRevocRegEntry"
+}
+
+func (msg *MsgCreateRevocRegEntry) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateRevocRegEntry) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateRevocRegEntry) ValidateBasic() error {
+	if msg.Payload == nil {
+		return ErrBadRequestIsRequired.Wrap("Payload")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Signatures")
+	}
+
+	return nil
+}
+
+var _ IdentityMsg = &MsgCreateRevocRegDefPayload{}
+
+func NewMsgCreateRevocRegDefPayload(
+	id string,
+	credDefId string,
+	revocDefType string,
+	tag string,
+	value *RevocRegDefValue,
+) *MsgCreateRevocRegDefPayload {
+	return &MsgCreateRevocRegDefPayload{
+		Id:           id,
+		CredDefId:    credDefId,
+		RevocDefType: revocDefType,
+		Tag:          tag,
+		Value:        value,
+	}
+}
+
+// GetSigners returns no signers because the payload doesn't carry the credential definition.
+// The controllers of the credential definition issuers have to sign the registry.
+func (msg *MsgCreateRevocRegDefPayload) GetSigners() []Signer {
+	return []Signer{}
+}
+
+func (msg *MsgCreateRevocRegDefPayload) Validate(namespace string, _ Params) error {
+	if !utils.IsValidDid(namespace, msg.Id) {
+		return ErrBadRequestIsNotDid.Wrap("Id")
+	}
+
+	if !utils.IsValidDid(namespace, msg.CredDefId) {
+		return ErrBadRequestIsNotDid.Wrap("CredDefId")
+	}
+
+	if msg.RevocDefType != RevocDefTypeCLAccum {
+		return ErrBadRequest.Wrapf("RevocDefType should be %s", RevocDefTypeCLAccum)
+	}
+
+	if len(msg.Tag) > 0 && !utils.DidForbiddenSymbolsRegexp.MatchString(msg.Tag) {
+		return ErrBadRequest.Wrap("Tag contains forbidden symbols")
+	}
+
+	if msg.Value == nil {
+		return ErrBadRequestIsRequired.Wrap("Value")
+	}
+
+	if msg.Value.MaxCredNum == 0 {
+		return ErrBadRequestIsRequired.Wrap("Value.MaxCredNum")
+	}
+
+	if len(msg.Value.TailsHash) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Value.TailsHash")
+	}
+
+	if len(msg.Value.TailsLocation) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Value.TailsLocation")
+	}
+
+	if msg.Value.IssuanceType != IssuanceByDefault && msg.Value.IssuanceType != IssuanceOnDemand {
+		return ErrBadRequest.Wrapf("Value.IssuanceType should be %s or %s", IssuanceByDefault, IssuanceOnDemand)
+	}
+
+	return nil
+}
+
+func (msg *MsgCreateRevocRegDefPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+var _ IdentityMsg = &MsgCreateRevocRegEntryPayload{}
+
+func NewMsgCreateRevocRegEntryPayload(
+	revocRegDefId string,
+	revocDefType string,
+	value *RevocRegEntryValue,
+) *MsgCreateRevocRegEntryPayload {
+	return &MsgCreateRevocRegEntryPayload{
+		RevocRegDefId: revocRegDefId,
+		RevocDefType:  revocDefType,
+		Value:         value,
+	}
+}
+
+// GetSigners returns no signers because the payload doesn't carry the credential definition.
+// The controllers of the credential definition issuers have to sign the entry.
+func (msg *MsgCreateRevocRegEntryPayload) GetSigners() []Signer {
+	return []Signer{}
+}
+
+func (msg *MsgCreateRevocRegEntryPayload) Validate(namespace string, _ Params) error {
+	if !utils.IsValidDid(namespace, msg.RevocRegDefId) {
+		return ErrBadRequestIsNotDid.Wrap("RevocRegDefId")
+	}
+
+	if msg.RevocDefType != RevocDefTypeCLAccum {
+		return ErrBadRequest.Wrapf("RevocDefType should be %s", RevocDefTypeCLAccum)
+	}
+
+	if msg.Value == nil {
+		return ErrBadRequestIsRequired.Wrap("Value")
+	}
+
+	if len(msg.Value.Accum) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Value.Accum")
+	}
+
+	issued := make(map[uint64]bool)
+	for _, index := range msg.Value.Issued {
+		issued[index] = true
+	}
+
+	for _, index := range msg.Value.Revoked {
+		if issued[index] {
+			return ErrBadRequest.Wrapf("Value index %d is both issued and revoked", index)
+		}
+	}
+
+	return nil
+}
+
+func (msg *MsgCreateRevocRegEntryPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
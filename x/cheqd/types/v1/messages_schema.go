@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateSchema{}
+
+func NewMsgCreateSchema(payload *MsgCreateSchemaPayload, signatures []*SignInfo) *MsgCreateSchema {
+	return &MsgCreateSchema{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateSchema) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateSchema) Type() string {
+	return "MsgCreateSchema"
+}
+
+func (msg *MsgCreateSchema) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateSchema) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateSchema) ValidateBasic() error {
+	if msg.Payload == nil {
+		return ErrBadRequestIsRequired.Wrap("Payload")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Signatures")
+	}
+
+	return nil
+}
+
+var _ IdentityMsg = &MsgCreateSchemaPayload{}
+
+func NewMsgCreateSchemaPayload(
+	id string,
+	schemaType string,
+	attrNames []string,
+	name string,
+	version string,
+	controller []string,
+) *MsgCreateSchemaPayload {
+	return &MsgCreateSchemaPayload{
+		Id:         id,
+		Type:       schemaType,
+		AttrNames:  attrNames,
+		Name:       name,
+		Version:    version,
+		Controller: controller,
+	}
+}
+
+// GetSigners returns no signers because the payload doesn't carry the issuer DID Docs.
+// The controllers of the stored issuer DID Docs have to sign the schema.
+func (msg *MsgCreateSchemaPayload) GetSigners() []Signer {
+	return []Signer{}
+}
+
+func (msg *MsgCreateSchemaPayload) Validate(namespace string, _ Params) error {
+	if !utils.IsValidDid(namespace, msg.Id) {
+		return ErrBadRequestIsNotDid.Wrap("Id")
+	}
+
+	if msg.Type != SchemaType {
+		return ErrBadRequest.Wrapf("Type should be %s", SchemaType)
+	}
+
+	if len(msg.Name) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Name")
+	}
+
+	if len(msg.Version) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Version")
+	}
+
+	if len(msg.AttrNames) == 0 {
+		return ErrBadRequestIsRequired.Wrap("AttrNames")
+	}
+
+	if len(msg.AttrNames) > MaxSchemaAttrNames {
+		return ErrBadRequest.Wrapf("AttrNames should contain at most %d items", MaxSchemaAttrNames)
+	}
+
+	for i, attrName := range msg.AttrNames {
+		if len(attrName) == 0 {
+			return ErrBadRequest.Wrapf("AttrNames item at position %d is empty", i)
+		}
+	}
+
+	if len(msg.Controller) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Controller")
+	}
+
+	if notValid, i := utils.IsNotValidDIDArray(namespace, msg.Controller); notValid {
+		return ErrBadRequestIsNotDid.Wrapf("Controller item %s at position %d", msg.Controller[i], i)
+	}
+
+	return nil
+}
+
+func (msg *MsgCreateSchemaPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
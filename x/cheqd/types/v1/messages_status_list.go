@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateStatusList{}
+
+func NewMsgCreateStatusList(payload *MsgCreateStatusListPayload, signatures []*SignInfo) *MsgCreateStatusList {
+	return &MsgCreateStatusList{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateStatusList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateStatusList) Type() string {
+	return "MsgCreateStatusList"
+}
+
+func (msg *MsgCreateStatusList) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateStatusList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateStatusList) ValidateBasic() error {
+	if msg.Payload == nil {
+		return ErrBadRequestIsRequired.Wrap("Payload")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Signatures")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateStatusList{}
+
+func NewMsgUpdateStatusList(payload *MsgUpdateStatusListPayload, signatures []*SignInfo) *MsgUpdateStatusList {
+	return &MsgUpdateStatusList{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgUpdateStatusList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateStatusList) Type() string {
+	return "MsgUpdateStatusList"
+}
+
+func (msg *MsgUpdateStatusList) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgUpdateStatusList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateStatusList) ValidateBasic() error {
+	if msg.Payload == nil {
+		return ErrBadRequestIsRequired.Wrap("Payload")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Signatures")
+	}
+
+	return nil
+}
+
+var _ IdentityMsg = &MsgCreateStatusListPayload{}
+
+func NewMsgCreateStatusListPayload(id string, statusPurpose string, length uint64, controller []string) *MsgCreateStatusListPayload {
+	return &MsgCreateStatusListPayload{
+		Id:            id,
+		StatusPurpose: statusPurpose,
+		Length:        length,
+		Controller:    controller,
+	}
+}
+
+// GetSigners returns no signers because the payload doesn't carry the owner DID Docs.
+// The controllers of the stored owner DID Docs have to sign the status list.
+func (msg *MsgCreateStatusListPayload) GetSigners() []Signer {
+	return []Signer{}
+}
+
+func (msg *MsgCreateStatusListPayload) Validate(namespace string, _ Params) error {
+	if !utils.IsValidDid(namespace, msg.Id) {
+		return ErrBadRequestIsNotDid.Wrap("Id")
+	}
+
+	if msg.StatusPurpose != StatusPurposeRevocation && msg.StatusPurpose != StatusPurposeSuspension {
+		return ErrBadRequest.Wrapf("StatusPurpose should be %s or %s", StatusPurposeRevocation, StatusPurposeSuspension)
+	}
+
+	if msg.Length < MinStatusListLength || msg.Length > MaxStatusListLength || msg.Length%8 != 0 {
+		return ErrBadRequest.Wrapf("Length should be a multiple of 8 between %d and %d", MinStatusListLength, MaxStatusListLength)
+	}
+
+	if len(msg.Controller) == 0 {
+		return ErrBadRequestIsRequired.Wrap("Controller")
+	}
+
+	if notValid, i := utils.IsNotValidDIDArray(namespace, msg.Controller); notValid {
+		return ErrBadRequestIsNotDid.Wrapf("Controller item %s at position %d", msg.Controller[i], i)
+	}
+
+	return nil
+}
+
+func (msg *MsgCreateStatusListPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+var _ IdentityMsg = &MsgUpdateStatusListPayload{}
+
+func NewMsgUpdateStatusListPayload(id string, set []uint64, unset []uint64, versionId string) *MsgUpdateStatusListPayload {
+	return &MsgUpdateStatusListPayload{
+		Id:        id,
+		Set:       set,
+		Unset:     unset,
+		VersionId: versionId,
+	}
+}
+
+// GetSigners returns no signers because the payload doesn't carry the owner DID Docs.
+// The controllers of the stored owner DID Docs have to sign the update.
+func (msg *MsgUpdateStatusListPayload) GetSigners() []Signer {
+	return []Signer{}
+}
+
+func (msg *MsgUpdateStatusListPayload) Validate(namespace string, _ Params) error {
+	if !utils.IsValidDid(namespace, msg.Id) {
+		return ErrBadRequestIsNotDid.Wrap("Id")
+	}
+
+	if len(msg.Set) == 0 && len(msg.Unset) == 0 {
+		return ErrBadRequest.Wrap("The message must contain either a Set or an Unset index")
+	}
+
+	set := make(map[uint64]bool)
+	for _, index := range msg.Set {
+		set[index] = true
+	}
+
+	for _, index := range msg.Unset {
+		if set[index] {
+			return ErrBadRequest.Wrapf("Index %d is both set and unset", index)
+		}
+	}
+
+	if len(msg.VersionId) == 0 {
+		return ErrBadRequestIsRequired.Wrap("VersionId")
+	}
+
+	return nil
+}
+
+func (msg *MsgUpdateStatusListPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
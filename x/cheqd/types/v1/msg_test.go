@@ -2,6 +2,7 @@ package v1
 
 import (
 	"github.com/stretchr/testify/require"
+	"strings"
 	"testing"
 )
 
@@ -303,10 +304,9 @@ func TestMsgCreateDidPayloadPayload(t *testing.T) {
 						Id:   "did:cheqd:test:alice#key-2",
 						Type: "JsonWebKey2020",
 						PublicKeyJwk: []*KeyValuePair{
-							{
-								Key:   "x",
-								Value: "sadad",
-							},
+							{Key: "kty", Value: "OKP"},
+							{Key: "crv", Value: "Ed25519"},
+							{Key: "x", Value: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"},
 						},
 						Controller: "did:cheqd:test:alice",
 					},
@@ -430,10 +430,9 @@ func TestMsgCreateDidPayloadPayload(t *testing.T) {
 						Id:   "did:cheqd:test:alice#key-1",
 						Type: "JsonWebKey2020",
 						PublicKeyJwk: []*KeyValuePair{
-							{
-								Key:   "x",
-								Value: "sadad",
-							},
+							{Key: "kty", Value: "OKP"},
+							{Key: "crv", Value: "Ed25519"},
+							{Key: "x", Value: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"},
 						},
 						Controller: "did:cheqd:test:alice",
 					},
@@ -450,7 +449,7 @@ func TestMsgCreateDidPayloadPayload(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		err := tc.msg.Validate(Prefix)
+		err := tc.msg.Validate(Prefix, DefaultParams())
 
 		if tc.valid {
 			require.Nil(t, err)
@@ -844,7 +843,7 @@ func TestNewMsgUpdateDidPayload(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		err := tc.msg.Validate(Prefix)
+		err := tc.msg.Validate(Prefix, DefaultParams())
 
 		if tc.valid {
 			require.Nil(t, err)
@@ -854,3 +853,408 @@ func TestNewMsgUpdateDidPayload(t *testing.T) {
 		}
 	}
 }
+
+func TestDidPayloadGetSigners(t *testing.T) {
+	vms := []*VerificationMethod{{Id: Prefix + "alice#key-1", Controller: Prefix + "alice"}}
+
+	cases := []struct {
+		name       string
+		controller []string
+		expected   []Signer
+	}{
+		{
+			name:     "Self-controlled without controllers",
+			expected: []Signer{{Signer: Prefix + "alice", Authentication: []string{Prefix + "alice#key-1"}, VerificationMethod: vms}},
+		},
+		{
+			name:       "Self and another controller",
+			controller: []string{Prefix + "alice", Prefix + "bob"},
+			expected: []Signer{
+				{Signer: Prefix + "alice", Authentication: []string{Prefix + "alice#key-1"}, VerificationMethod: vms},
+				{Signer: Prefix + "bob"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			createPayload := &MsgCreateDidPayload{Id: Prefix + "alice", Controller: tc.controller, VerificationMethod: vms, Authentication: []string{Prefix + "alice#key-1"}}
+			updatePayload := &MsgUpdateDidPayload{Id: Prefix + "alice", Controller: tc.controller, VerificationMethod: vms, Authentication: []string{Prefix + "alice#key-1"}}
+			did := createPayload.ToDid()
+
+			require.Equal(t, tc.expected, did.GetSigners())
+			require.Equal(t, tc.expected, createPayload.GetSigners())
+			require.Equal(t, tc.expected, updatePayload.GetSigners())
+		})
+	}
+}
+
+func TestNewMsgDeactivateDidValidation(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *MsgDeactivateDid
+		errMsg string
+	}{
+		{true, "Valid Deactivate Did Msg", NewMsgDeactivateDid(&MsgDeactivateDidPayload{Id: "1"}, []*SignInfo{{VerificationMethodId: "foo", Signature: "bar"}}), ""},
+		{false, "Payload is missed", NewMsgDeactivateDid(nil, nil), "Payload: is required"},
+		{false, "Signatures is missed", NewMsgDeactivateDid(&MsgDeactivateDidPayload{Id: "1"}, nil), "Signatures: is required"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewMsgDeactivateDidPayload(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		msg    *MsgDeactivateDidPayload
+		errMsg string
+	}{
+		{false, &MsgDeactivateDidPayload{}, "Id: is not DID"},
+		{false, &MsgDeactivateDidPayload{Id: "did:ch:test:alice", VersionId: "1"}, "Id: is not DID"},
+		{false, &MsgDeactivateDidPayload{Id: "did:cheqd:test:alice"}, "VersionId: is required"},
+		{true, &MsgDeactivateDidPayload{Id: "did:cheqd:test:alice", VersionId: "1"}, ""},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.Validate(Prefix, DefaultParams())
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.Error(t, err)
+			require.Equal(t, tc.errMsg, err.Error())
+		}
+	}
+}
+
+func TestNewMsgCreateSchemaPayload(t *testing.T) {
+	tooManyAttrNames := make([]string, MaxSchemaAttrNames+1)
+	for i := range tooManyAttrNames {
+		tooManyAttrNames[i] = "attr"
+	}
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *MsgCreateSchemaPayload
+		errMsg string
+	}{
+		{
+			true,
+			"Valid schema",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", SchemaType, []string{"first_name"}, "Degree", "1.0", []string{"did:cheqd:test:alice"}),
+			"",
+		},
+		{
+			false,
+			"Id is not DID",
+			NewMsgCreateSchemaPayload("did:ch:test:schema", SchemaType, []string{"first_name"}, "Degree", "1.0", []string{"did:cheqd:test:alice"}),
+			"Id: is not DID",
+		},
+		{
+			false,
+			"Id has resource type",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema?service=CL-Schema", SchemaType, []string{"first_name"}, "Degree", "1.0", []string{"did:cheqd:test:alice"}),
+			"Id: is not DID",
+		},
+		{
+			false,
+			"Unsupported type",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", "JSON-Schema", []string{"first_name"}, "Degree", "1.0", []string{"did:cheqd:test:alice"}),
+			"Type should be CL-Schema: bad request",
+		},
+		{
+			false,
+			"Name is missed",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", SchemaType, []string{"first_name"}, "", "1.0", []string{"did:cheqd:test:alice"}),
+			"Name: is required",
+		},
+		{
+			false,
+			"Version is missed",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", SchemaType, []string{"first_name"}, "Degree", "", []string{"did:cheqd:test:alice"}),
+			"Version: is required",
+		},
+		{
+			false,
+			"AttrNames is missed",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", SchemaType, nil, "Degree", "1.0", []string{"did:cheqd:test:alice"}),
+			"AttrNames: is required",
+		},
+		{
+			false,
+			"Too many AttrNames",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", SchemaType, tooManyAttrNames, "Degree", "1.0", []string{"did:cheqd:test:alice"}),
+			"AttrNames should contain at most 125 items: bad request",
+		},
+		{
+			false,
+			"Empty attribute name",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", SchemaType, []string{"first_name", ""}, "Degree", "1.0", []string{"did:cheqd:test:alice"}),
+			"AttrNames item at position 1 is empty: bad request",
+		},
+		{
+			false,
+			"Controller is missed",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", SchemaType, []string{"first_name"}, "Degree", "1.0", nil),
+			"Controller: is required",
+		},
+		{
+			false,
+			"Controller is not DID",
+			NewMsgCreateSchemaPayload("did:cheqd:test:schema", SchemaType, []string{"first_name"}, "Degree", "1.0", []string{"did:cheqd:other:alice"}),
+			"Controller item did:cheqd:other:alice at position 0: is not DID",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(Prefix, DefaultParams())
+
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewMsgCreateCredDefPayload(t *testing.T) {
+	value := &CredDefValue{Primary: "{\"n\":\"1\"}"}
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *MsgCreateCredDefPayload
+		errMsg string
+	}{
+		{
+			true,
+			"Valid cred def",
+			NewMsgCreateCredDefPayload("did:cheqd:test:cred-def", "did:cheqd:test:schema", CredDefSignatureType, "", value, []string{"did:cheqd:test:alice"}),
+			"",
+		},
+		{
+			false,
+			"Id is not DID",
+			NewMsgCreateCredDefPayload("cred-def", "did:cheqd:test:schema", CredDefSignatureType, "", value, []string{"did:cheqd:test:alice"}),
+			"Id: is not DID",
+		},
+		{
+			false,
+			"SchemaId is not DID",
+			NewMsgCreateCredDefPayload("did:cheqd:test:cred-def", "did:cheqd:other:schema", CredDefSignatureType, "", value, []string{"did:cheqd:test:alice"}),
+			"SchemaId: is not DID",
+		},
+		{
+			false,
+			"Unsupported signature type",
+			NewMsgCreateCredDefPayload("did:cheqd:test:cred-def", "did:cheqd:test:schema", "BBS+", "", value, []string{"did:cheqd:test:alice"}),
+			"SignatureType should be CL-Sig-Cred_def: bad request",
+		},
+		{
+			false,
+			"Tag with forbidden symbols",
+			NewMsgCreateCredDefPayload("did:cheqd:test:cred-def", "did:cheqd:test:schema", CredDefSignatureType, "a/b", value, []string{"did:cheqd:test:alice"}),
+			"Tag contains forbidden symbols: bad request",
+		},
+		{
+			false,
+			"Value is missed",
+			NewMsgCreateCredDefPayload("did:cheqd:test:cred-def", "did:cheqd:test:schema", CredDefSignatureType, "", nil, []string{"did:cheqd:test:alice"}),
+			"Value.Primary: is required",
+		},
+		{
+			false,
+			"Controller is missed",
+			NewMsgCreateCredDefPayload("did:cheqd:test:cred-def", "did:cheqd:test:schema", CredDefSignatureType, "", value, nil),
+			"Controller: is required",
+		},
+		{
+			false,
+			"Controller is not DID",
+			NewMsgCreateCredDefPayload("did:cheqd:test:cred-def", "did:cheqd:test:schema", CredDefSignatureType, "", value, []string{"alice"}),
+			"Controller item alice at position 0: is not DID",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(Prefix, DefaultParams())
+
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewMsgCreateRevocRegDefPayload(t *testing.T) {
+	value := func() *RevocRegDefValue {
+		return &RevocRegDefValue{MaxCredNum: 100, TailsHash: "hash", TailsLocation: "http://tails", IssuanceType: IssuanceByDefault}
+	}
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *MsgCreateRevocRegDefPayload
+		errMsg string
+	}{
+		{true, "Valid revoc reg def", NewMsgCreateRevocRegDefPayload("did:cheqd:test:rev", "did:cheqd:test:cred-def", RevocDefTypeCLAccum, "tag1", value()), ""},
+		{false, "Id is not DID", NewMsgCreateRevocRegDefPayload("rev", "did:cheqd:test:cred-def", RevocDefTypeCLAccum, "tag1", value()), "Id: is not DID"},
+		{false, "CredDefId is not DID", NewMsgCreateRevocRegDefPayload("did:cheqd:test:rev", "cred-def", RevocDefTypeCLAccum, "tag1", value()), "CredDefId: is not DID"},
+		{false, "Unsupported type", NewMsgCreateRevocRegDefPayload("did:cheqd:test:rev", "did:cheqd:test:cred-def", "CL", "tag1", value()), "RevocDefType should be CL_ACCUM: bad request"},
+		{false, "Value is missed", NewMsgCreateRevocRegDefPayload("did:cheqd:test:rev", "did:cheqd:test:cred-def", RevocDefTypeCLAccum, "tag1", nil), "Value: is required"},
+		{false, "MaxCredNum is missed", NewMsgCreateRevocRegDefPayload("did:cheqd:test:rev", "did:cheqd:test:cred-def", RevocDefTypeCLAccum, "tag1", &RevocRegDefValue{TailsHash: "hash", TailsLocation: "http://tails", IssuanceType: IssuanceByDefault}), "Value.MaxCredNum: is required"},
+		{false, "Unknown issuance type", NewMsgCreateRevocRegDefPayload("did:cheqd:test:rev", "did:cheqd:test:cred-def", RevocDefTypeCLAccum, "tag1", &RevocRegDefValue{MaxCredNum: 100, TailsHash: "hash", TailsLocation: "http://tails", IssuanceType: "ALWAYS"}), "Value.IssuanceType should be ISSUANCE_BY_DEFAULT or ISSUANCE_ON_DEMAND: bad request"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(Prefix, DefaultParams())
+
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewMsgCreateRevocRegEntryPayload(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *MsgCreateRevocRegEntryPayload
+		errMsg string
+	}{
+		{true, "Valid entry", NewMsgCreateRevocRegEntryPayload("did:cheqd:test:rev", RevocDefTypeCLAccum, &RevocRegEntryValue{Accum: "2", PrevAccum: "1", Revoked: []uint64{1}}), ""},
+		{false, "RevocRegDefId is not DID", NewMsgCreateRevocRegEntryPayload("rev", RevocDefTypeCLAccum, &RevocRegEntryValue{Accum: "2"}), "RevocRegDefId: is not DID"},
+		{false, "Accum is missed", NewMsgCreateRevocRegEntryPayload("did:cheqd:test:rev", RevocDefTypeCLAccum, &RevocRegEntryValue{}), "Value.Accum: is required"},
+		{false, "Index is issued and revoked", NewMsgCreateRevocRegEntryPayload("did:cheqd:test:rev", RevocDefTypeCLAccum, &RevocRegEntryValue{Accum: "2", Issued: []uint64{1}, Revoked: []uint64{1}}), "Value index 1 is both issued and revoked: bad request"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(Prefix, DefaultParams())
+
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewMsgCreateStatusListPayload(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *MsgCreateStatusListPayload
+		errMsg string
+	}{
+		{true, "Valid status list", NewMsgCreateStatusListPayload("did:cheqd:test:list", StatusPurposeRevocation, MinStatusListLength, []string{"did:cheqd:test:alice"}), ""},
+		{false, "Id is not DID", NewMsgCreateStatusListPayload("list", StatusPurposeRevocation, MinStatusListLength, []string{"did:cheqd:test:alice"}), "Id: is not DID"},
+		{false, "Unknown status purpose", NewMsgCreateStatusListPayload("did:cheqd:test:list", "expiration", MinStatusListLength, []string{"did:cheqd:test:alice"}), "StatusPurpose should be revocation or suspension: bad request"},
+		{false, "Too short list", NewMsgCreateStatusListPayload("did:cheqd:test:list", StatusPurposeRevocation, 8, []string{"did:cheqd:test:alice"}), "Length should be a multiple of 8 between 131072 and 1048576: bad request"},
+		{false, "Length isn't a multiple of 8", NewMsgCreateStatusListPayload("did:cheqd:test:list", StatusPurposeRevocation, MinStatusListLength+1, []string{"did:cheqd:test:alice"}), "Length should be a multiple of 8 between 131072 and 1048576: bad request"},
+		{false, "Controller is missed", NewMsgCreateStatusListPayload("did:cheqd:test:list", StatusPurposeRevocation, MinStatusListLength, nil), "Controller: is required"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(Prefix, DefaultParams())
+
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewMsgUpdateStatusListPayload(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *MsgUpdateStatusListPayload
+		errMsg string
+	}{
+		{true, "Valid update", NewMsgUpdateStatusListPayload("did:cheqd:test:list", []uint64{1}, []uint64{2}, "1"), ""},
+		{false, "Id is not DID", NewMsgUpdateStatusListPayload("list", []uint64{1}, nil, "1"), "Id: is not DID"},
+		{false, "No indices", NewMsgUpdateStatusListPayload("did:cheqd:test:list", nil, nil, "1"), "The message must contain either a Set or an Unset index: bad request"},
+		{false, "Index is set and unset", NewMsgUpdateStatusListPayload("did:cheqd:test:list", []uint64{1}, []uint64{1}, "1"), "Index 1 is both set and unset: bad request"},
+		{false, "VersionId is missed", NewMsgUpdateStatusListPayload("did:cheqd:test:list", []uint64{1}, nil, ""), "VersionId: is required"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(Prefix, DefaultParams())
+
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewMsgCreateResourcePayload(t *testing.T) {
+	id := "a09abea0-22e0-4b35-8f70-9cc3a6d0b5fd"
+
+	cases := []struct {
+		valid  bool
+		name   string
+		msg    *MsgCreateResourcePayload
+		errMsg string
+	}{
+		{true, "Valid resource", NewMsgCreateResourcePayload("did:cheqd:test:alice", id, "Logo", "CL-Schema", "image/png", []byte{1}), ""},
+		{false, "CollectionId is not DID", NewMsgCreateResourcePayload("alice", id, "Logo", "CL-Schema", "image/png", []byte{1}), "CollectionId: is not DID"},
+		{false, "Id is not UUID", NewMsgCreateResourcePayload("did:cheqd:test:alice", "logo", "Logo", "CL-Schema", "image/png", []byte{1}), "Id should be UUID: bad request"},
+		{false, "Name is missed", NewMsgCreateResourcePayload("did:cheqd:test:alice", id, "", "CL-Schema", "image/png", []byte{1}), "Name: is required"},
+		{false, "Name is too long", NewMsgCreateResourcePayload("did:cheqd:test:alice", id, strings.Repeat("a", MaxResourceNameLength+1), "CL-Schema", "image/png", []byte{1}), "Name should be at most 128 bytes: bad request"},
+		{false, "ResourceType is missed", NewMsgCreateResourcePayload("did:cheqd:test:alice", id, "Logo", "", "image/png", []byte{1}), "ResourceType: is required"},
+		{false, "ResourceType is too long", NewMsgCreateResourcePayload("did:cheqd:test:alice", id, "Logo", strings.Repeat("a", MaxResourceTypeLength+1), "image/png", []byte{1}), "ResourceType should be at most 64 bytes: bad request"},
+		{false, "Invalid media type", NewMsgCreateResourcePayload("did:cheqd:test:alice", id, "Logo", "CL-Schema", "image/", []byte{1}), "MediaType is invalid: mime: expected token after slash: bad request"},
+		{false, "Data is missed", NewMsgCreateResourcePayload("did:cheqd:test:alice", id, "Logo", "CL-Schema", "image/png", nil), "Data: is required"},
+		{false, "Data is too large", NewMsgCreateResourcePayload("did:cheqd:test:alice", id, "Logo", "CL-Schema", "image/png", make([]byte, MaxResourceSize+1)), "Data should be at most 204800 bytes: bad request"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(Prefix, DefaultParams())
+
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils/strings"
+	"math"
+	gostrings "strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	KeyVerificationMethodTypes = []byte("VerificationMethodTypes")
+	KeyServiceTypes            = []byte("ServiceTypes")
+	KeyMaxVerificationMethods  = []byte("MaxVerificationMethods")
+	KeyMaxServices             = []byte("MaxServices")
+	KeyMaxControllers          = []byte("MaxControllers")
+	KeyMaxDidDocSize           = []byte("MaxDidDocSize")
+	KeyIdentityFees            = []byte("IdentityFees")
+	KeyBurnFactor              = []byte("BurnFactor")
+)
+
+const (
+	DefaultMaxVerificationMethods uint64 = 64
+	DefaultMaxServices            uint64 = 64
+	DefaultMaxControllers         uint64 = 64
+	DefaultMaxDidDocSize          uint64 = 64 * 1024
+)
+
+// IdentityMsgTypeURLPrefix is the type URL prefix of the messages identity fees can be set for
+const IdentityMsgTypeURLPrefix = "/cheqdid.cheqdnode.cheqd."
+
+// VerificationMaterials are the verification material properties verification method types can accept
+var VerificationMaterials = []string{utils.PublicKeyJwk, utils.PublicKeyMultibase}
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table of the cheqd module params
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	verificationMethodTypes []*VerificationMethodType,
+	serviceTypes []string,
+	maxVerificationMethods uint64,
+	maxServices uint64,
+	maxControllers uint64,
+	maxDidDocSize uint64,
+	identityFees []*IdentityFee,
+	burnFactor sdk.Dec,
+) Params {
+	return Params{
+		VerificationMethodTypes: verificationMethodTypes,
+		ServiceTypes:            serviceTypes,
+		MaxVerificationMethods:  maxVerificationMethods,
+		MaxServices:             maxServices,
+		MaxControllers:          maxControllers,
+		MaxDidDocSize:           maxDidDocSize,
+		IdentityFees:            identityFees,
+		BurnFactor:              burnFactor.String(),
+	}
+}
+
+// DefaultParams returns the params the module had before they became configurable.
+// Identity messages aren't charged by default, the fees are set by governance.
+func DefaultParams() Params {
+	return NewParams(
+		[]*VerificationMethodType{
+			{Type: utils.JsonWebKey2020, Materials: []string{utils.PublicKeyJwk}},
+			{Type: utils.Ed25519VerificationKey2020, Materials: []string{utils.PublicKeyMultibase}},
+			{Type: utils.EcdsaSecp256k1VerificationKey2019, Materials: []string{utils.PublicKeyJwk, utils.PublicKeyMultibase}},
+			{Type: utils.EcdsaSecp256k1RecoveryMethod2020, Materials: []string{utils.PublicKeyJwk, utils.PublicKeyMultibase}},
+		},
+		[]string{utils.LinkedDomains, utils.DIDCommMessaging},
+		DefaultMaxVerificationMethods,
+		DefaultMaxServices,
+		DefaultMaxControllers,
+		DefaultMaxDidDocSize,
+		nil,
+		sdk.ZeroDec(),
+	)
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVerificationMethodTypes, &p.VerificationMethodTypes, validateVerificationMethodTypes),
+		paramtypes.NewParamSetPair(KeyServiceTypes, &p.ServiceTypes, validateServiceTypes),
+		paramtypes.NewParamSetPair(KeyMaxVerificationMethods, &p.MaxVerificationMethods, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxServices, &p.MaxServices, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxControllers, &p.MaxControllers, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxDidDocSize, &p.MaxDidDocSize, validateLimit),
+		paramtypes.NewParamSetPair(KeyIdentityFees, &p.IdentityFees, validateIdentityFees),
+		paramtypes.NewParamSetPair(KeyBurnFactor, &p.BurnFactor, validateBurnFactor),
+	}
+}
+
+func (p Params) Validate() error {
+	if err := validateVerificationMethodTypes(p.VerificationMethodTypes); err != nil {
+		return err
+	}
+
+	if err := validateServiceTypes(p.ServiceTypes); err != nil {
+		return err
+	}
+
+	for _, limit := range []uint64{p.MaxVerificationMethods, p.MaxServices, p.MaxControllers, p.MaxDidDocSize} {
+		if err := validateLimit(limit); err != nil {
+			return err
+		}
+	}
+
+	if err := validateIdentityFees(p.IdentityFees); err != nil {
+		return err
+	}
+
+	return validateBurnFactor(p.BurnFactor)
+}
+
+// GetVerificationMaterials returns the verification material properties the verification method type accepts.
+// Types which are not allowed accept nothing.
+func (p Params) GetVerificationMaterials(vmType string) []string {
+	for _, t := range p.VerificationMethodTypes {
+		if t.Type == vmType {
+			return t.Materials
+		}
+	}
+
+	return nil
+}
+
+func (p Params) IsAllowedServiceType(sType string) bool {
+	return strings.Contains(p.ServiceTypes, sType)
+}
+
+// ValidateDidDocLimits checks the number of the DID Doc items and its size
+func (p Params) ValidateDidDocLimits(did *Did) error {
+	if uint64(len(did.VerificationMethod)) > p.MaxVerificationMethods {
+		return ErrBadRequestInvalidVerMethod.Wrapf("DID Doc can't have more than %d verification methods", p.MaxVerificationMethods)
+	}
+
+	if uint64(len(did.Service)) > p.MaxServices {
+		return ErrBadRequestInvalidService.Wrapf("DID Doc can't have more than %d services", p.MaxServices)
+	}
+
+	if uint64(len(did.Controller)) > p.MaxControllers {
+		return ErrBadRequest.Wrapf("DID Doc can't have more than %d controllers", p.MaxControllers)
+	}
+
+	if uint64(did.Size()) > p.MaxDidDocSize {
+		return ErrBadRequest.Wrapf("DID Doc size %d exceeds %d bytes", did.Size(), p.MaxDidDocSize)
+	}
+
+	return nil
+}
+
+// PermissiveParams returns params which accept the verification method and service types of the DID Doc
+// and any number of its items. Stored DID Docs are checked with them, so param changes don't make them invalid.
+func PermissiveParams(did *Did) Params {
+	params := Params{
+		MaxVerificationMethods: math.MaxUint64,
+		MaxServices:            math.MaxUint64,
+		MaxControllers:         math.MaxUint64,
+		MaxDidDocSize:          math.MaxUint64,
+	}
+
+	for _, vm := range did.VerificationMethod {
+		if params.GetVerificationMaterials(vm.Type) == nil {
+			params.VerificationMethodTypes = append(params.VerificationMethodTypes, &VerificationMethodType{Type: vm.Type, Materials: VerificationMaterials})
+		}
+	}
+
+	for _, service := range did.Service {
+		if !params.IsAllowedServiceType(service.Type) {
+			params.ServiceTypes = append(params.ServiceTypes, service.Type)
+		}
+	}
+
+	return params
+}
+
+// GetIdentityFee returns the fixed fee of the message type. Messages without a fee cost nothing.
+func (p Params) GetIdentityFee(msgTypeURL string) sdk.Coins {
+	for _, fee := range p.IdentityFees {
+		if fee.MsgTypeUrl == msgTypeURL {
+			return NewCoins(fee.Amount)
+		}
+	}
+
+	return sdk.NewCoins()
+}
+
+// GetBurnFactorDec returns the share of the identity fees which is burnt
+func (p Params) GetBurnFactorDec() sdk.Dec {
+	// Validated params always have a correct burn factor
+	factor, err := sdk.NewDecFromStr(p.BurnFactor)
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+
+	return factor
+}
+
+// NewCoins converts protobuf coins into sorted sdk.Coins
+func NewCoins(coins []*sdk.Coin) sdk.Coins {
+	result := make(sdk.Coins, 0, len(coins))
+	for _, coin := range coins {
+		result = append(result, *coin)
+	}
+
+	return result.Sort()
+}
+
+func validateVerificationMethodTypes(i interface{}) error {
+	types, ok := i.([]*VerificationMethodType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make([]string, 0, len(types))
+
+	for _, t := range types {
+		if t == nil || len(t.Type) == 0 {
+			return fmt.Errorf("verification method type can't be empty")
+		}
+
+		if strings.Contains(names, t.Type) {
+			return fmt.Errorf("verification method type %s is duplicated", t.Type)
+		}
+
+		if len(t.Materials) == 0 {
+			return fmt.Errorf("verification method type %s should accept at least one verification material", t.Type)
+		}
+
+		for _, material := range t.Materials {
+			if !strings.Contains(VerificationMaterials, material) {
+				return fmt.Errorf("verification method type %s: unknown verification material %s", t.Type, material)
+			}
+		}
+
+		if _, found := signatureVerifiers[t.Type]; !found {
+			return fmt.Errorf("verification method type %s has no signature verifier", t.Type)
+		}
+
+		names = append(names, t.Type)
+	}
+
+	return nil
+}
+
+func validateServiceTypes(i interface{}) error {
+	types, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, t := range types {
+		if len(t) == 0 {
+			return fmt.Errorf("service type can't be empty")
+		}
+
+		if strings.Contains(types[j+1:], t) {
+			return fmt.Errorf("service type %s is duplicated", t)
+		}
+	}
+
+	return nil
+}
+
+func validateLimit(i interface{}) error {
+	limit, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if limit == 0 {
+		return fmt.Errorf("limit should be positive")
+	}
+
+	return nil
+}
+
+func validateIdentityFees(i interface{}) error {
+	fees, ok := i.([]*IdentityFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	msgTypeURLs := make([]string, 0, len(fees))
+
+	for _, fee := range fees {
+		if fee == nil || !gostrings.HasPrefix(fee.MsgTypeUrl, IdentityMsgTypeURLPrefix) {
+			return fmt.Errorf("identity fee should be set for a message with %s type URL prefix", IdentityMsgTypeURLPrefix)
+		}
+
+		if strings.Contains(msgTypeURLs, fee.MsgTypeUrl) {
+			return fmt.Errorf("identity fee of %s is duplicated", fee.MsgTypeUrl)
+		}
+
+		for _, coin := range fee.Amount {
+			if coin == nil {
+				return fmt.Errorf("identity fee of %s: coin can't be empty", fee.MsgTypeUrl)
+			}
+		}
+
+		if err := NewCoins(fee.Amount).Validate(); err != nil {
+			return fmt.Errorf("identity fee of %s: %s", fee.MsgTypeUrl, err)
+		}
+
+		msgTypeURLs = append(msgTypeURLs, fee.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+func validateBurnFactor(i interface{}) error {
+	value, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	factor, err := sdk.NewDecFromStr(value)
+	if err != nil {
+		return fmt.Errorf("burn factor: %s", err)
+	}
+
+	if factor.IsNegative() || factor.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn factor should be between 0 and 1, got %s", factor)
+	}
+
+	return nil
+}
@@ -0,0 +1,1121 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/params.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the cheqd module. They can be changed by `ParameterChangeProposal`.
+type Params struct {
+	// Allowed verification method types
+	VerificationMethodTypes []*VerificationMethodType `protobuf:"bytes,1,rep,name=verification_method_types,json=verificationMethodTypes,proto3" json:"verification_method_types,omitempty"`
+	// Allowed service types
+	ServiceTypes           []string `protobuf:"bytes,2,rep,name=service_types,json=serviceTypes,proto3" json:"service_types,omitempty"`
+	MaxVerificationMethods uint64   `protobuf:"varint,3,opt,name=max_verification_methods,json=maxVerificationMethods,proto3" json:"max_verification_methods,omitempty"`
+	MaxServices            uint64   `protobuf:"varint,4,opt,name=max_services,json=maxServices,proto3" json:"max_services,omitempty"`
+	MaxControllers         uint64   `protobuf:"varint,5,opt,name=max_controllers,json=maxControllers,proto3" json:"max_controllers,omitempty"`
+	// Maximal size of a protobuf serialized DID Doc in bytes
+	MaxDidDocSize uint64 `protobuf:"varint,6,opt,name=max_did_doc_size,json=maxDidDocSize,proto3" json:"max_did_doc_size,omitempty"`
+	// Fixed fees of identity messages, charged on top of the gas fee of the tx
+	IdentityFees []*IdentityFee `protobuf:"bytes,7,rep,name=identity_fees,json=identityFees,proto3" json:"identity_fees,omitempty"`
+	// Decimal share of the identity fees which is burnt, from 0 to 1
+	BurnFactor string `protobuf:"bytes,8,opt,name=burn_factor,json=burnFactor,proto3" json:"burn_factor,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c4e8b0b9dda0170, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetVerificationMethodTypes() []*VerificationMethodType {
+	if m != nil {
+		return m.VerificationMethodTypes
+	}
+	return nil
+}
+
+func (m *Params) GetServiceTypes() []string {
+	if m != nil {
+		return m.ServiceTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxVerificationMethods() uint64 {
+	if m != nil {
+		return m.MaxVerificationMethods
+	}
+	return 0
+}
+
+func (m *Params) GetMaxServices() uint64 {
+	if m != nil {
+		return m.MaxServices
+	}
+	return 0
+}
+
+func (m *Params) GetMaxControllers() uint64 {
+	if m != nil {
+		return m.MaxControllers
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDidDocSize() uint64 {
+	if m != nil {
+		return m.MaxDidDocSize
+	}
+	return 0
+}
+
+func (m *Params) GetIdentityFees() []*IdentityFee {
+	if m != nil {
+		return m.IdentityFees
+	}
+	return nil
+}
+
+func (m *Params) GetBurnFactor() string {
+	if m != nil {
+		return m.BurnFactor
+	}
+	return ""
+}
+
+// VerificationMethodType lists the verification material properties a verification method type accepts.
+// The first property is the preferred one.
+type VerificationMethodType struct {
+	Type      string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Materials []string `protobuf:"bytes,2,rep,name=materials,proto3" json:"materials,omitempty"`
+}
+
+func (m *VerificationMethodType) Reset()         { *m = VerificationMethodType{} }
+func (m *VerificationMethodType) String() string { return proto.CompactTextString(m) }
+func (*VerificationMethodType) ProtoMessage()    {}
+func (*VerificationMethodType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c4e8b0b9dda0170, []int{1}
+}
+func (m *VerificationMethodType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationMethodType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationMethodType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerificationMethodType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationMethodType.Merge(m, src)
+}
+func (m *VerificationMethodType) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationMethodType) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationMethodType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationMethodType proto.InternalMessageInfo
+
+func (m *VerificationMethodType) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *VerificationMethodType) GetMaterials() []string {
+	if m != nil {
+		return m.Materials
+	}
+	return nil
+}
+
+// IdentityFee is the fixed fee of an identity message type.
+type IdentityFee struct {
+	// Type URL of the message, e.g. /cheqdid.cheqdnode.cheqd.v1.MsgCreateDid
+	MsgTypeUrl string        `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Amount     []*types.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *IdentityFee) Reset()         { *m = IdentityFee{} }
+func (m *IdentityFee) String() string { return proto.CompactTextString(m) }
+func (*IdentityFee) ProtoMessage()    {}
+func (*IdentityFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c4e8b0b9dda0170, []int{2}
+}
+func (m *IdentityFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentityFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentityFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentityFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentityFee.Merge(m, src)
+}
+func (m *IdentityFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentityFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentityFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentityFee proto.InternalMessageInfo
+
+func (m *IdentityFee) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *IdentityFee) GetAmount() []*types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cheqdid.cheqdnode.cheqd.v1.Params")
+	proto.RegisterType((*VerificationMethodType)(nil), "cheqdid.cheqdnode.cheqd.v1.VerificationMethodType")
+	proto.RegisterType((*IdentityFee)(nil), "cheqdid.cheqdnode.cheqd.v1.IdentityFee")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/params.proto", fileDescriptor_5c4e8b0b9dda0170) }
+
+var fileDescriptor_5c4e8b0b9dda0170 = []byte{
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8e, 0xd3, 0x30,
+	0x18, 0x85, 0x1b, 0x5a, 0x0a, 0x75, 0x5a, 0x40, 0x96, 0x18, 0x32, 0x23, 0x14, 0x42, 0x59, 0xb4,
+	0x0b, 0x70, 0x94, 0xb2, 0x61, 0xcd, 0x8c, 0x2a, 0x81, 0x40, 0x42, 0x19, 0x60, 0xc1, 0x26, 0x72,
+	0x9c, 0xbf, 0xad, 0xa5, 0x38, 0x2e, 0xb6, 0x1b, 0xa5, 0x73, 0x0a, 0xee, 0xc3, 0x05, 0x58, 0xce,
+	0x92, 0x25, 0x6a, 0x2f, 0x82, 0xe2, 0x04, 0x75, 0x24, 0x0a, 0x9b, 0xc4, 0xf9, 0xf4, 0xde, 0xff,
+	0x5b, 0x2f, 0x0f, 0x3d, 0x64, 0x2b, 0xf8, 0x9a, 0x85, 0x65, 0x14, 0xae, 0xa9, 0xa2, 0x42, 0x93,
+	0xb5, 0x92, 0x46, 0xe2, 0x33, 0x8b, 0x79, 0x46, 0xec, 0xbb, 0x90, 0x19, 0x34, 0x27, 0x52, 0x46,
+	0x67, 0x3e, 0x93, 0x5a, 0x48, 0x1d, 0xa6, 0x54, 0x43, 0x58, 0x46, 0x29, 0x18, 0x1a, 0x85, 0x4c,
+	0xf2, 0xa2, 0xf1, 0x8e, 0xbf, 0x77, 0x51, 0xff, 0x83, 0x1d, 0x86, 0x0b, 0x74, 0x5a, 0x82, 0xe2,
+	0x0b, 0xce, 0xa8, 0xe1, 0xb2, 0x48, 0x04, 0x98, 0x95, 0xcc, 0x12, 0xb3, 0x5d, 0x83, 0xf6, 0x9c,
+	0xa0, 0x3b, 0x75, 0x67, 0x33, 0xf2, 0xef, 0x55, 0xe4, 0xf3, 0x0d, 0xf3, 0x7b, 0xeb, 0xfd, 0xb8,
+	0x5d, 0x43, 0xfc, 0xa8, 0x3c, 0xca, 0x35, 0x7e, 0x86, 0x46, 0x1a, 0x54, 0xc9, 0x19, 0xb4, 0x3b,
+	0x6e, 0x05, 0xdd, 0xe9, 0x20, 0x1e, 0xb6, 0xb0, 0x11, 0xbd, 0x42, 0x9e, 0xa0, 0x55, 0x72, 0xe4,
+	0x62, 0xda, 0xeb, 0x06, 0xce, 0xb4, 0x17, 0x9f, 0x08, 0x5a, 0xfd, 0xbd, 0x5a, 0xe3, 0xa7, 0x68,
+	0x58, 0x3b, 0xdb, 0x69, 0xda, 0xeb, 0x59, 0xb5, 0x2b, 0x68, 0x75, 0xd9, 0x22, 0x3c, 0x41, 0xf7,
+	0x6b, 0x09, 0x93, 0x85, 0x51, 0x32, 0xcf, 0x41, 0x69, 0xef, 0xb6, 0x55, 0xdd, 0x13, 0xb4, 0x3a,
+	0x3f, 0x50, 0x3c, 0x41, 0x0f, 0x6a, 0x61, 0xc6, 0xb3, 0x24, 0x93, 0x2c, 0xd1, 0xfc, 0x0a, 0xbc,
+	0xbe, 0x55, 0x8e, 0x04, 0xad, 0x2e, 0x78, 0x76, 0x21, 0xd9, 0x25, 0xbf, 0x02, 0xfc, 0x0e, 0x8d,
+	0x78, 0x06, 0x85, 0xe1, 0x66, 0x9b, 0x2c, 0x00, 0xb4, 0x77, 0xc7, 0xe6, 0x36, 0xf9, 0x5f, 0x6e,
+	0x6f, 0x5a, 0xc3, 0x1c, 0x20, 0x1e, 0xf2, 0xc3, 0x87, 0xc6, 0x4f, 0x90, 0x9b, 0x6e, 0x54, 0x91,
+	0x2c, 0x28, 0x33, 0x52, 0x79, 0x77, 0x03, 0x67, 0x3a, 0x88, 0x51, 0x8d, 0xe6, 0x96, 0x8c, 0xdf,
+	0xa2, 0x93, 0xe3, 0xa9, 0x63, 0x8c, 0x7a, 0x75, 0xa8, 0x9e, 0x63, 0x3d, 0xf6, 0x8c, 0x1f, 0xa3,
+	0x81, 0xa0, 0x06, 0x14, 0xa7, 0xf9, 0x9f, 0xb0, 0x0f, 0x60, 0x9c, 0x22, 0xf7, 0xc6, 0x4d, 0x70,
+	0x80, 0x86, 0x42, 0x2f, 0xed, 0x9f, 0x49, 0x36, 0x2a, 0x6f, 0x07, 0x21, 0xa1, 0x97, 0xf5, 0xfc,
+	0x4f, 0x2a, 0xc7, 0x11, 0xea, 0x53, 0x21, 0x37, 0x85, 0xb1, 0xb3, 0xdc, 0xd9, 0x29, 0x69, 0xba,
+	0x46, 0xea, 0xae, 0x91, 0xb6, 0x6b, 0xe4, 0x5c, 0xf2, 0x22, 0x6e, 0x85, 0xaf, 0xe7, 0x3f, 0x76,
+	0xbe, 0x73, 0xbd, 0xf3, 0x9d, 0x5f, 0x3b, 0xdf, 0xf9, 0xb6, 0xf7, 0x3b, 0xd7, 0x7b, 0xbf, 0xf3,
+	0x73, 0xef, 0x77, 0xbe, 0x3c, 0x5f, 0x72, 0xb3, 0xda, 0xa4, 0x84, 0x49, 0x11, 0x36, 0x2d, 0xb7,
+	0xcf, 0x17, 0x75, 0x54, 0x61, 0xd5, 0x22, 0x5b, 0x93, 0xba, 0xc5, 0x7d, 0x5b, 0xde, 0x97, 0xbf,
+	0x07, 0x00, 0xdd, 0x18, 0xb3, 0xf9, 0x11, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BurnFactor) > 0 {
+		i -= len(m.BurnFactor)
+		copy(dAtA[i:], m.BurnFactor)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BurnFactor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.IdentityFees) > 0 {
+		for iNdEx := len(m.IdentityFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IdentityFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MaxDidDocSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDidDocSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxControllers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxControllers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxServices != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxServices))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxVerificationMethods != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVerificationMethods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ServiceTypes) > 0 {
+		for iNdEx := len(m.ServiceTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ServiceTypes[iNdEx])
+			copy(dAtA[i:], m.ServiceTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ServiceTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.VerificationMethodTypes) > 0 {
+		for iNdEx := len(m.VerificationMethodTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationMethodTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationMethodType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationMethodType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationMethodType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Materials) > 0 {
+		for iNdEx := len(m.Materials) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Materials[iNdEx])
+			copy(dAtA[i:], m.Materials[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Materials[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentityFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentityFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentityFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VerificationMethodTypes) > 0 {
+		for _, e := range m.VerificationMethodTypes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ServiceTypes) > 0 {
+		for _, s := range m.ServiceTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxVerificationMethods != 0 {
+		n += 1 + sovParams(uint64(m.MaxVerificationMethods))
+	}
+	if m.MaxServices != 0 {
+		n += 1 + sovParams(uint64(m.MaxServices))
+	}
+	if m.MaxControllers != 0 {
+		n += 1 + sovParams(uint64(m.MaxControllers))
+	}
+	if m.MaxDidDocSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxDidDocSize))
+	}
+	if len(m.IdentityFees) > 0 {
+		for _, e := range m.IdentityFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.BurnFactor)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *VerificationMethodType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Materials) > 0 {
+		for _, s := range m.Materials {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentityFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethodTypes = append(m.VerificationMethodTypes, &VerificationMethodType{})
+			if err := m.VerificationMethodTypes[len(m.VerificationMethodTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceTypes = append(m.ServiceTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVerificationMethods", wireType)
+			}
+			m.MaxVerificationMethods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVerificationMethods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxServices", wireType)
+			}
+			m.MaxServices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxServices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxControllers", wireType)
+			}
+			m.MaxControllers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxControllers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDidDocSize", wireType)
+			}
+			m.MaxDidDocSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDidDocSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityFees = append(m.IdentityFees, &IdentityFee{})
+			if err := m.IdentityFees[len(m.IdentityFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationMethodType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationMethodType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationMethodType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Materials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Materials = append(m.Materials, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentityFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentityFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentityFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.Nil(t, DefaultParams().Validate())
+
+	cases := []struct {
+		name   string
+		modify func(params *Params)
+		errMsg string
+	}{
+		{
+			name: "Duplicated verification method type",
+			modify: func(p *Params) {
+				p.VerificationMethodTypes = append(p.VerificationMethodTypes, p.VerificationMethodTypes[0])
+			},
+			errMsg: "verification method type JsonWebKey2020 is duplicated",
+		},
+		{
+			name: "Unknown verification material",
+			modify: func(p *Params) {
+				p.VerificationMethodTypes = []*VerificationMethodType{{Type: "Bls12381G2Key2020", Materials: []string{"PublicKeyBase58"}}}
+			},
+			errMsg: "verification method type Bls12381G2Key2020: unknown verification material PublicKeyBase58",
+		},
+		{
+			name:   "Verification method type without materials",
+			modify: func(p *Params) { p.VerificationMethodTypes = []*VerificationMethodType{{Type: "Bls12381G2Key2020"}} },
+			errMsg: "verification method type Bls12381G2Key2020 should accept at least one verification material",
+		},
+		{
+			name: "Verification method type without signature verifier",
+			modify: func(p *Params) {
+				p.VerificationMethodTypes = []*VerificationMethodType{{Type: "Bls12381G2Key2020", Materials: []string{"PublicKeyMultibase"}}}
+			},
+			errMsg: "verification method type Bls12381G2Key2020 has no signature verifier",
+		},
+		{
+			name:   "Duplicated service type",
+			modify: func(p *Params) { p.ServiceTypes = []string{"LinkedDomains", "LinkedDomains"} },
+			errMsg: "service type LinkedDomains is duplicated",
+		},
+		{
+			name:   "Zero limit",
+			modify: func(p *Params) { p.MaxDidDocSize = 0 },
+			errMsg: "limit should be positive",
+		},
+		{
+			name:   "Identity fee of a non identity message",
+			modify: func(p *Params) { p.IdentityFees = []*IdentityFee{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"}} },
+			errMsg: "identity fee should be set for a message with /cheqdid.cheqdnode.cheqd. type URL prefix",
+		},
+		{
+			name: "Duplicated identity fee",
+			modify: func(p *Params) {
+				p.IdentityFees = []*IdentityFee{{MsgTypeUrl: "/cheqdid.cheqdnode.cheqd.v1.MsgCreateDid"}, {MsgTypeUrl: "/cheqdid.cheqdnode.cheqd.v1.MsgCreateDid"}}
+			},
+			errMsg: "identity fee of /cheqdid.cheqdnode.cheqd.v1.MsgCreateDid is duplicated",
+		},
+		{
+			name: "Zero identity fee",
+			modify: func(p *Params) {
+				p.IdentityFees = []*IdentityFee{{MsgTypeUrl: "/cheqdid.cheqdnode.cheqd.v1.MsgCreateDid", Amount: []*sdk.Coin{{Denom: "ncheq", Amount: sdk.ZeroInt()}}}}
+			},
+			errMsg: "identity fee of /cheqdid.cheqdnode.cheqd.v1.MsgCreateDid: coin 0ncheq amount is not positive",
+		},
+		{
+			name:   "Burn factor above one",
+			modify: func(p *Params) { p.BurnFactor = "1.1" },
+			errMsg: "burn factor should be between 0 and 1, got 1.100000000000000000",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.modify(&params)
+			require.EqualError(t, params.Validate(), tc.errMsg)
+		})
+	}
+}
+
+func TestValidateDidDocLimits(t *testing.T) {
+	params := DefaultParams()
+	params.MaxControllers = 1
+	params.MaxDidDocSize = 100
+
+	did := &Did{Id: "did:cheqd:test:alice", Controller: []string{"did:cheqd:test:alice"}}
+	require.Nil(t, params.ValidateDidDocLimits(did))
+
+	did.Controller = append(did.Controller, "did:cheqd:test:bob")
+	require.EqualError(t, params.ValidateDidDocLimits(did), "DID Doc can't have more than 1 controllers: bad request")
+
+	did.Controller = nil
+	did.AlsoKnownAs = []string{string(make([]byte, 100))}
+	require.Error(t, params.ValidateDidDocLimits(did))
+}
@@ -0,0 +1,248 @@
+package v1
+
+import (
+	"time"
+)
+
+const (
+	DidResolutionContext = "https://w3id.org/did-resolution/v1"
+	DidDocumentContext   = "https://www.w3.org/ns/did/v1"
+
+	DidResolutionContentType = "application/ld+json;profile=\"https://w3id.org/did-resolution\""
+	DidLdJsonContentType     = "application/did+ld+json"
+	DidJsonContentType       = "application/did+json"
+	UriListContentType       = "text/uri-list"
+)
+
+// DID Resolution error codes, see https://www.w3.org/TR/did-spec-registries/#error
+const (
+	ResolutionInvalidDid         = "invalidDid"
+	ResolutionInvalidDidUrl      = "invalidDidUrl"
+	ResolutionNotFound           = "notFound"
+	ResolutionMethodNotSupported = "methodNotSupported"
+	ResolutionInternalError      = "internalError"
+)
+
+// DidResolutionResult is the W3C DID Resolution Result
+type DidResolutionResult struct {
+	Context               string                `json:"@context"`
+	DidResolutionMetadata DidResolutionMetadata `json:"didResolutionMetadata"`
+	DidDocument           *DidDocument          `json:"didDocument"`
+	DidDocumentMetadata   *DidDocumentMetadata  `json:"didDocumentMetadata"`
+}
+
+// DidDereferencingResult is the W3C DID URL Dereferencing Result.
+// ContentStream is a DID Doc, a part of it, a service endpoint URL or resource data.
+type DidDereferencingResult struct {
+	Context               string                `json:"@context"`
+	DereferencingMetadata DidResolutionMetadata `json:"dereferencingMetadata"`
+	ContentStream         interface{}           `json:"contentStream"`
+	ContentMetadata       interface{}           `json:"contentMetadata"`
+}
+
+type DidResolutionMetadata struct {
+	ContentType string `json:"contentType,omitempty"`
+	Retrieved   string `json:"retrieved"`
+	Error       string `json:"error,omitempty"`
+}
+
+type DidDocumentMetadata struct {
+	Created     string `json:"created"`
+	Updated     string `json:"updated,omitempty"`
+	Deactivated bool   `json:"deactivated,omitempty"`
+	VersionId   string `json:"versionId"`
+}
+
+// ResourceMetadata is the JSON-LD representation of ResourceHeader
+type ResourceMetadata struct {
+	CollectionId      string `json:"resourceCollectionId"`
+	Id                string `json:"resourceId"`
+	Name              string `json:"resourceName"`
+	ResourceType      string `json:"resourceType"`
+	MediaType         string `json:"mediaType"`
+	Created           string `json:"created"`
+	Checksum          string `json:"checksum"`
+	PreviousVersionId string `json:"previousVersionId,omitempty"`
+	NextVersionId     string `json:"nextVersionId,omitempty"`
+}
+
+// DidDocument is the JSON-LD representation of Did
+type DidDocument struct {
+	Context              []string                   `json:"@context,omitempty"`
+	Id                   string                     `json:"id"`
+	Controller           []string                   `json:"controller,omitempty"`
+	VerificationMethod   []DidDocVerificationMethod `json:"verificationMethod,omitempty"`
+	Authentication       []string                   `json:"authentication,omitempty"`
+	AssertionMethod      []string                   `json:"assertionMethod,omitempty"`
+	CapabilityInvocation []string                   `json:"capabilityInvocation,omitempty"`
+	CapabilityDelegation []string                   `json:"capabilityDelegation,omitempty"`
+	KeyAgreement         []string                   `json:"keyAgreement,omitempty"`
+	Service              []DidDocService            `json:"service,omitempty"`
+	AlsoKnownAs          []string                   `json:"alsoKnownAs,omitempty"`
+}
+
+type DidDocVerificationMethod struct {
+	Id                 string            `json:"id"`
+	Type               string            `json:"type"`
+	Controller         string            `json:"controller"`
+	PublicKeyJwk       map[string]string `json:"publicKeyJwk,omitempty"`
+	PublicKeyMultibase string            `json:"publicKeyMultibase,omitempty"`
+}
+
+type DidDocService struct {
+	Id              string `json:"id"`
+	Type            string `json:"type"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+}
+
+// NewDidResolutionResult builds the resolution result of a stored DID Doc
+func NewDidResolutionResult(did *Did, metadata *Metadata, retrieved time.Time) (*DidResolutionResult, error) {
+	didDocMetadata, err := NewDidDocumentMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	didDoc := NewDidDocument(did)
+
+	return &DidResolutionResult{
+		Context: DidResolutionContext,
+		DidResolutionMetadata: DidResolutionMetadata{
+			ContentType: DidLdJsonContentType,
+			Retrieved:   FormatResolutionTime(retrieved),
+		},
+		DidDocument:         &didDoc,
+		DidDocumentMetadata: didDocMetadata,
+	}, nil
+}
+
+// NewDidResolutionError builds the resolution result for one of the DID Resolution error codes
+func NewDidResolutionError(code string, retrieved time.Time) *DidResolutionResult {
+	return &DidResolutionResult{
+		Context: DidResolutionContext,
+		DidResolutionMetadata: DidResolutionMetadata{
+			Retrieved: FormatResolutionTime(retrieved),
+			Error:     code,
+		},
+	}
+}
+
+// NewDidDereferencingResult builds the dereferencing result of the selected content
+func NewDidDereferencingResult(contentType string, content interface{}, contentMetadata interface{}, retrieved time.Time) *DidDereferencingResult {
+	return &DidDereferencingResult{
+		Context: DidResolutionContext,
+		DereferencingMetadata: DidResolutionMetadata{
+			ContentType: contentType,
+			Retrieved:   FormatResolutionTime(retrieved),
+		},
+		ContentStream:   content,
+		ContentMetadata: contentMetadata,
+	}
+}
+
+// NewDidDereferencingError builds the dereferencing result for one of the DID Resolution error codes
+func NewDidDereferencingError(code string, retrieved time.Time) *DidDereferencingResult {
+	return &DidDereferencingResult{
+		Context: DidResolutionContext,
+		DereferencingMetadata: DidResolutionMetadata{
+			Retrieved: FormatResolutionTime(retrieved),
+			Error:     code,
+		},
+	}
+}
+
+// NewDidDocumentMetadata converts `created` and `updated` metadata fields to XML datetime
+func NewDidDocumentMetadata(metadata *Metadata) (*DidDocumentMetadata, error) {
+	created, err := metadata.GetCreatedTime()
+	if err != nil {
+		return nil, err
+	}
+
+	result := DidDocumentMetadata{
+		Created:     FormatResolutionTime(created),
+		Deactivated: metadata.Deactivated,
+		VersionId:   metadata.VersionId,
+	}
+
+	if metadata.Updated != metadata.Created {
+		updated, err := metadata.GetUpdatedTime()
+		if err != nil {
+			return nil, err
+		}
+
+		result.Updated = FormatResolutionTime(updated)
+	}
+
+	return &result, nil
+}
+
+func NewDidDocument(did *Did) DidDocument {
+	context := did.Context
+	if len(context) == 0 {
+		context = []string{DidDocumentContext}
+	}
+
+	result := DidDocument{
+		Context:              context,
+		Id:                   did.Id,
+		Controller:           did.Controller,
+		Authentication:       did.Authentication,
+		AssertionMethod:      did.AssertionMethod,
+		CapabilityInvocation: did.CapabilityInvocation,
+		CapabilityDelegation: did.CapabilityDelegation,
+		KeyAgreement:         did.KeyAgreement,
+		AlsoKnownAs:          did.AlsoKnownAs,
+	}
+
+	for _, vm := range did.VerificationMethod {
+		result.VerificationMethod = append(result.VerificationMethod, NewDidDocVerificationMethod(vm))
+	}
+
+	for _, service := range did.Service {
+		result.Service = append(result.Service, DidDocService{
+			Id:              service.Id,
+			Type:            service.Type,
+			ServiceEndpoint: service.ServiceEndpoint,
+		})
+	}
+
+	return result
+}
+
+func NewDidDocVerificationMethod(vm *VerificationMethod) DidDocVerificationMethod {
+	result := DidDocVerificationMethod{
+		Id:                 vm.Id,
+		Type:               vm.Type,
+		Controller:         vm.Controller,
+		PublicKeyMultibase: vm.PublicKeyMultibase,
+	}
+
+	if len(vm.PublicKeyJwk) > 0 {
+		result.PublicKeyJwk = vm.GetJwk()
+	}
+
+	return result
+}
+
+func NewResourceMetadata(header *ResourceHeader) (*ResourceMetadata, error) {
+	created, err := time.Parse(MetadataTimeLayout, header.Created)
+	if err != nil {
+		return nil, ErrInvalidDidStateValue.Wrap(err.Error())
+	}
+
+	return &ResourceMetadata{
+		CollectionId:      header.CollectionId,
+		Id:                header.Id,
+		Name:              header.Name,
+		ResourceType:      header.ResourceType,
+		MediaType:         header.MediaType,
+		Created:           FormatResolutionTime(created),
+		Checksum:          header.Checksum,
+		PreviousVersionId: header.PreviousVersionId,
+		NextVersionId:     header.NextVersionId,
+	}, nil
+}
+
+// FormatResolutionTime formats time as XML datetime normalized to UTC
+func FormatResolutionTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDidDocumentMetadata(t *testing.T) {
+	created := time.Date(2021, 1, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+	updated := created.Add(time.Hour)
+
+	metadata := Metadata{Created: created.String(), Updated: updated.String(), Deactivated: true, VersionId: "version"}
+	result, err := NewDidDocumentMetadata(&metadata)
+
+	require.Nil(t, err)
+	require.Equal(t, "2021-01-01T11:00:00Z", result.Created)
+	require.Equal(t, "2021-01-01T12:00:00Z", result.Updated)
+	require.True(t, result.Deactivated)
+	require.Equal(t, "version", result.VersionId)
+
+	metadata.Created = "yesterday"
+	_, err = NewDidDocumentMetadata(&metadata)
+	require.Error(t, err)
+}
+
+func TestNewResourceMetadata(t *testing.T) {
+	created := time.Date(2021, 1, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	header := ResourceHeader{CollectionId: "alice", Id: "resource", Created: created.String()}
+	result, err := NewResourceMetadata(&header)
+
+	require.Nil(t, err)
+	require.Equal(t, "2021-01-01T11:00:00Z", result.Created)
+
+	header.Created = "yesterday"
+	_, err = NewResourceMetadata(&header)
+	require.Error(t, err)
+}
+
+func TestNewDidDocVerificationMethodJwk(t *testing.T) {
+	vm := VerificationMethod{
+		Id:         "did:cheqd:test:alice#key-1",
+		Type:       "JsonWebKey2020",
+		Controller: "did:cheqd:test:alice",
+		PublicKeyJwk: []*KeyValuePair{
+			{Key: "kty", Value: "OKP"},
+			{Key: "crv", Value: "Ed25519"},
+			{Key: "x", Value: "VCpo2LMLhn6iWku8MKvSLg2ZAoC-nlOyPVQaO3FxVeQ"},
+		},
+	}
+
+	bytes, err := json.Marshal(NewDidDocVerificationMethod(&vm))
+	require.Nil(t, err)
+	require.Equal(t,
+		`{"id":"did:cheqd:test:alice#key-1","type":"JsonWebKey2020","controller":"did:cheqd:test:alice",`+
+			`"publicKeyJwk":{"crv":"Ed25519","kty":"OKP","x":"VCpo2LMLhn6iWku8MKvSLg2ZAoC-nlOyPVQaO3FxVeQ"}}`,
+		string(bytes))
+}
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	// MaxResourceSize is the maximal size of resource data in bytes
+	MaxResourceSize = 200 * 1024
+
+	// MaxResourceNameLength is the maximal length of resource names
+	MaxResourceNameLength = 128
+
+	// MaxResourceTypeLength is the maximal length of resource types
+	MaxResourceTypeLength = 64
+)
+
+// GetChecksum returns the hex encoded SHA-256 digest of the data
+func GetChecksum(data []byte) string {
+	checksum := sha256.Sum256(data)
+	return hex.EncodeToString(checksum[:])
+}
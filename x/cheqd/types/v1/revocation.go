@@ -0,0 +1,75 @@
+package v1
+
+import "sort"
+
+const (
+	// RevocDefTypeCLAccum is the only supported revocation type
+	RevocDefTypeCLAccum = "CL_ACCUM"
+
+	// IssuanceByDefault means all credentials are issued initially and entries list revoked indices
+	IssuanceByDefault = "ISSUANCE_BY_DEFAULT"
+
+	// IssuanceOnDemand means no credentials are issued initially and entries list issued and revoked indices
+	IssuanceOnDemand = "ISSUANCE_ON_DEMAND"
+)
+
+// IsRevokedInitially returns whether credentials of the registry are revoked until an entry issues them
+func (def *RevocRegDef) IsRevokedInitially() bool {
+	return def.Value.IssuanceType == IssuanceOnDemand
+}
+
+// NewRevocRegDelta accumulates the entries of a registry into a delta.
+// Entries must be ordered by creation, the delta starts after the first `fromCount` entries.
+func NewRevocRegDelta(def *RevocRegDef, entries []*RevocRegEntry, fromCount int) *RevocRegDelta {
+	delta := RevocRegDelta{RevocRegDefId: def.Id}
+
+	if len(entries) > 0 {
+		delta.Accum = entries[len(entries)-1].Value.Accum
+	}
+
+	if fromCount > 0 {
+		delta.PrevAccum = entries[fromCount-1].Value.Accum
+	}
+
+	fromState := revocationState(entries[:fromCount])
+	toState := revocationState(entries)
+
+	for index, revoked := range toState {
+		wasRevoked, ok := fromState[index]
+		if !ok {
+			wasRevoked = def.IsRevokedInitially()
+		}
+
+		if wasRevoked == revoked {
+			continue
+		}
+
+		if revoked {
+			delta.Revoked = append(delta.Revoked, index)
+		} else {
+			delta.Issued = append(delta.Issued, index)
+		}
+	}
+
+	sort.Slice(delta.Issued, func(i, j int) bool { return delta.Issued[i] < delta.Issued[j] })
+	sort.Slice(delta.Revoked, func(i, j int) bool { return delta.Revoked[i] < delta.Revoked[j] })
+
+	return &delta
+}
+
+// revocationState returns whether each index changed by the entries is revoked
+func revocationState(entries []*RevocRegEntry) map[uint64]bool {
+	state := make(map[uint64]bool)
+
+	for _, entry := range entries {
+		for _, index := range entry.Value.Issued {
+			state[index] = false
+		}
+
+		for _, index := range entry.Value.Revoked {
+			state[index] = true
+		}
+	}
+
+	return state
+}
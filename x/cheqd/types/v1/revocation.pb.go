@@ -0,0 +1,2117 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/revocation.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RevocRegDef struct {
+	Id           string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CredDefId    string            `protobuf:"bytes,2,opt,name=cred_def_id,json=credDefId,proto3" json:"cred_def_id,omitempty"`
+	RevocDefType string            `protobuf:"bytes,3,opt,name=revoc_def_type,json=revocDefType,proto3" json:"revoc_def_type,omitempty"`
+	Tag          string            `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Value        *RevocRegDefValue `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *RevocRegDef) Reset()         { *m = RevocRegDef{} }
+func (m *RevocRegDef) String() string { return proto.CompactTextString(m) }
+func (*RevocRegDef) ProtoMessage()    {}
+func (*RevocRegDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba1e52f4bbb01aca, []int{0}
+}
+func (m *RevocRegDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocRegDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocRegDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocRegDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocRegDef.Merge(m, src)
+}
+func (m *RevocRegDef) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocRegDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocRegDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocRegDef proto.InternalMessageInfo
+
+func (m *RevocRegDef) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetCredDefId() string {
+	if m != nil {
+		return m.CredDefId
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetRevocDefType() string {
+	if m != nil {
+		return m.RevocDefType
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetValue() *RevocRegDefValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type RevocRegDefValue struct {
+	MaxCredNum    uint64 `protobuf:"varint,1,opt,name=max_cred_num,json=maxCredNum,proto3" json:"max_cred_num,omitempty"`
+	TailsHash     string `protobuf:"bytes,2,opt,name=tails_hash,json=tailsHash,proto3" json:"tails_hash,omitempty"`
+	TailsLocation string `protobuf:"bytes,3,opt,name=tails_location,json=tailsLocation,proto3" json:"tails_location,omitempty"`
+	IssuanceType  string `protobuf:"bytes,4,opt,name=issuance_type,json=issuanceType,proto3" json:"issuance_type,omitempty"`
+	PublicKeys    string `protobuf:"bytes,5,opt,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (m *RevocRegDefValue) Reset()         { *m = RevocRegDefValue{} }
+func (m *RevocRegDefValue) String() string { return proto.CompactTextString(m) }
+func (*RevocRegDefValue) ProtoMessage()    {}
+func (*RevocRegDefValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba1e52f4bbb01aca, []int{1}
+}
+func (m *RevocRegDefValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocRegDefValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocRegDefValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocRegDefValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocRegDefValue.Merge(m, src)
+}
+func (m *RevocRegDefValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocRegDefValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocRegDefValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocRegDefValue proto.InternalMessageInfo
+
+func (m *RevocRegDefValue) GetMaxCredNum() uint64 {
+	if m != nil {
+		return m.MaxCredNum
+	}
+	return 0
+}
+
+func (m *RevocRegDefValue) GetTailsHash() string {
+	if m != nil {
+		return m.TailsHash
+	}
+	return ""
+}
+
+func (m *RevocRegDefValue) GetTailsLocation() string {
+	if m != nil {
+		return m.TailsLocation
+	}
+	return ""
+}
+
+func (m *RevocRegDefValue) GetIssuanceType() string {
+	if m != nil {
+		return m.IssuanceType
+	}
+	return ""
+}
+
+func (m *RevocRegDefValue) GetPublicKeys() string {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return ""
+}
+
+type RevocRegEntry struct {
+	RevocRegDefId string              `protobuf:"bytes,1,opt,name=revoc_reg_def_id,json=revocRegDefId,proto3" json:"revoc_reg_def_id,omitempty"`
+	RevocDefType  string              `protobuf:"bytes,2,opt,name=revoc_def_type,json=revocDefType,proto3" json:"revoc_def_type,omitempty"`
+	Value         *RevocRegEntryValue `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *RevocRegEntry) Reset()         { *m = RevocRegEntry{} }
+func (m *RevocRegEntry) String() string { return proto.CompactTextString(m) }
+func (*RevocRegEntry) ProtoMessage()    {}
+func (*RevocRegEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba1e52f4bbb01aca, []int{2}
+}
+func (m *RevocRegEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocRegEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocRegEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocRegEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocRegEntry.Merge(m, src)
+}
+func (m *RevocRegEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocRegEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocRegEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocRegEntry proto.InternalMessageInfo
+
+func (m *RevocRegEntry) GetRevocRegDefId() string {
+	if m != nil {
+		return m.RevocRegDefId
+	}
+	return ""
+}
+
+func (m *RevocRegEntry) GetRevocDefType() string {
+	if m != nil {
+		return m.RevocDefType
+	}
+	return ""
+}
+
+func (m *RevocRegEntry) GetValue() *RevocRegEntryValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type RevocRegEntryValue struct {
+	Accum     string   `protobuf:"bytes,1,opt,name=accum,proto3" json:"accum,omitempty"`
+	PrevAccum string   `protobuf:"bytes,2,opt,name=prev_accum,json=prevAccum,proto3" json:"prev_accum,omitempty"`
+	Issued    []uint64 `protobuf:"varint,3,rep,packed,name=issued,proto3" json:"issued,omitempty"`
+	Revoked   []uint64 `protobuf:"varint,4,rep,packed,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *RevocRegEntryValue) Reset()         { *m = RevocRegEntryValue{} }
+func (m *RevocRegEntryValue) String() string { return proto.CompactTextString(m) }
+func (*RevocRegEntryValue) ProtoMessage()    {}
+func (*RevocRegEntryValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba1e52f4bbb01aca, []int{3}
+}
+func (m *RevocRegEntryValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocRegEntryValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocRegEntryValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocRegEntryValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocRegEntryValue.Merge(m, src)
+}
+func (m *RevocRegEntryValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocRegEntryValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocRegEntryValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocRegEntryValue proto.InternalMessageInfo
+
+func (m *RevocRegEntryValue) GetAccum() string {
+	if m != nil {
+		return m.Accum
+	}
+	return ""
+}
+
+func (m *RevocRegEntryValue) GetPrevAccum() string {
+	if m != nil {
+		return m.PrevAccum
+	}
+	return ""
+}
+
+func (m *RevocRegEntryValue) GetIssued() []uint64 {
+	if m != nil {
+		return m.Issued
+	}
+	return nil
+}
+
+func (m *RevocRegEntryValue) GetRevoked() []uint64 {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+// RevocRegDelta is the accumulated change of a revocation registry between two points in time
+type RevocRegDelta struct {
+	RevocRegDefId string   `protobuf:"bytes,1,opt,name=revoc_reg_def_id,json=revocRegDefId,proto3" json:"revoc_reg_def_id,omitempty"`
+	Accum         string   `protobuf:"bytes,2,opt,name=accum,proto3" json:"accum,omitempty"`
+	PrevAccum     string   `protobuf:"bytes,3,opt,name=prev_accum,json=prevAccum,proto3" json:"prev_accum,omitempty"`
+	Issued        []uint64 `protobuf:"varint,4,rep,packed,name=issued,proto3" json:"issued,omitempty"`
+	Revoked       []uint64 `protobuf:"varint,5,rep,packed,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *RevocRegDelta) Reset()         { *m = RevocRegDelta{} }
+func (m *RevocRegDelta) String() string { return proto.CompactTextString(m) }
+func (*RevocRegDelta) ProtoMessage()    {}
+func (*RevocRegDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba1e52f4bbb01aca, []int{4}
+}
+func (m *RevocRegDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocRegDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocRegDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocRegDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocRegDelta.Merge(m, src)
+}
+func (m *RevocRegDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocRegDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocRegDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocRegDelta proto.InternalMessageInfo
+
+func (m *RevocRegDelta) GetRevocRegDefId() string {
+	if m != nil {
+		return m.RevocRegDefId
+	}
+	return ""
+}
+
+func (m *RevocRegDelta) GetAccum() string {
+	if m != nil {
+		return m.Accum
+	}
+	return ""
+}
+
+func (m *RevocRegDelta) GetPrevAccum() string {
+	if m != nil {
+		return m.PrevAccum
+	}
+	return ""
+}
+
+func (m *RevocRegDelta) GetIssued() []uint64 {
+	if m != nil {
+		return m.Issued
+	}
+	return nil
+}
+
+func (m *RevocRegDelta) GetRevoked() []uint64 {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RevocRegDef)(nil), "cheqdid.cheqdnode.cheqd.v1.RevocRegDef")
+	proto.RegisterType((*RevocRegDefValue)(nil), "cheqdid.cheqdnode.cheqd.v1.RevocRegDefValue")
+	proto.RegisterType((*RevocRegEntry)(nil), "cheqdid.cheqdnode.cheqd.v1.RevocRegEntry")
+	proto.RegisterType((*RevocRegEntryValue)(nil), "cheqdid.cheqdnode.cheqd.v1.RevocRegEntryValue")
+	proto.RegisterType((*RevocRegDelta)(nil), "cheqdid.cheqdnode.cheqd.v1.RevocRegDelta")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/revocation.proto", fileDescriptor_ba1e52f4bbb01aca) }
+
+var fileDescriptor_ba1e52f4bbb01aca = []byte{
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbd, 0x8e, 0xd3, 0x40,
+	0x14, 0x85, 0xe3, 0x38, 0x59, 0x94, 0x9b, 0x1f, 0x45, 0x23, 0x84, 0x0c, 0x12, 0x26, 0x0a, 0x20,
+	0x52, 0x2c, 0x8e, 0x02, 0x4f, 0xc0, 0x12, 0x10, 0x08, 0x44, 0x61, 0x21, 0x0a, 0x1a, 0x6b, 0xe2,
+	0xb9, 0x89, 0xad, 0xf5, 0x1f, 0xfe, 0x53, 0x2c, 0x5e, 0x82, 0x37, 0xa0, 0xe1, 0x25, 0xe8, 0x28,
+	0x29, 0xb7, 0xa4, 0x44, 0xc9, 0x8b, 0xa0, 0xb9, 0x63, 0xc3, 0x2e, 0xbb, 0x91, 0xb6, 0x49, 0x66,
+	0xce, 0x99, 0x2b, 0x9f, 0xf3, 0x8d, 0x06, 0x6e, 0xbb, 0x1e, 0x7e, 0x12, 0xf3, 0x72, 0x31, 0x4f,
+	0xb1, 0x8c, 0x5d, 0x9e, 0xfb, 0x71, 0x64, 0x25, 0x69, 0x9c, 0xc7, 0xec, 0x0e, 0x59, 0xbe, 0xb0,
+	0xe8, 0x3f, 0x8a, 0x05, 0xaa, 0x95, 0x55, 0x2e, 0xa6, 0xdf, 0x35, 0xe8, 0xdb, 0x72, 0xc0, 0xc6,
+	0xcd, 0x12, 0xd7, 0x6c, 0x04, 0x6d, 0x5f, 0x18, 0xda, 0x44, 0x9b, 0xf5, 0xec, 0xb6, 0x2f, 0x98,
+	0x09, 0x7d, 0x37, 0x45, 0xe1, 0x08, 0x5c, 0x3b, 0xbe, 0x30, 0xda, 0x64, 0xf4, 0xa4, 0xb4, 0xc4,
+	0xf5, 0x6b, 0xc1, 0x1e, 0xc0, 0x88, 0xbe, 0x47, 0x07, 0xf2, 0x2a, 0x41, 0x43, 0xa7, 0x23, 0x03,
+	0x52, 0x97, 0xb8, 0x7e, 0x5f, 0x25, 0xc8, 0xc6, 0xa0, 0xe7, 0x7c, 0x63, 0x74, 0xc8, 0x92, 0x4b,
+	0x76, 0x02, 0xdd, 0x92, 0x07, 0x05, 0x1a, 0xdd, 0x89, 0x36, 0xeb, 0x3f, 0x39, 0xb6, 0x0e, 0x67,
+	0xb4, 0xce, 0xe5, 0xfb, 0x20, 0x67, 0x6c, 0x35, 0x3a, 0xfd, 0xa1, 0xc1, 0xf8, 0x7f, 0x8f, 0x4d,
+	0x60, 0x10, 0xf2, 0xad, 0x43, 0xa1, 0xa3, 0x22, 0xa4, 0x2a, 0x1d, 0x1b, 0x42, 0xbe, 0x7d, 0x9e,
+	0xa2, 0x78, 0x57, 0x84, 0xec, 0x2e, 0x40, 0xce, 0xfd, 0x20, 0x73, 0x3c, 0x9e, 0x79, 0x4d, 0x23,
+	0x52, 0x5e, 0xf1, 0xcc, 0x63, 0x0f, 0x61, 0xa4, 0xec, 0xa0, 0xa6, 0x58, 0x37, 0x1a, 0x92, 0xfa,
+	0xb6, 0x16, 0xd9, 0x7d, 0x18, 0xfa, 0x59, 0x56, 0xf0, 0xc8, 0x45, 0xd5, 0x5b, 0x95, 0x1b, 0x34,
+	0x22, 0xf5, 0xbe, 0x07, 0xfd, 0xa4, 0x58, 0x05, 0xbe, 0xeb, 0x9c, 0x62, 0x95, 0x51, 0xd7, 0x9e,
+	0x0d, 0x4a, 0x7a, 0x83, 0x55, 0x36, 0xfd, 0xa6, 0xc1, 0xb0, 0xa9, 0xf0, 0x22, 0xca, 0xd3, 0x8a,
+	0x3d, 0x82, 0xb1, 0x02, 0x9a, 0xe2, 0xa6, 0xa1, 0xae, 0xae, 0x63, 0x98, 0xfe, 0xeb, 0x7a, 0x25,
+	0xf9, 0xf6, 0x15, 0xe4, 0x97, 0x0d, 0x67, 0x9d, 0x38, 0x5b, 0xd7, 0xe1, 0x4c, 0x41, 0x2e, 0x90,
+	0xfe, 0x0c, 0xec, 0xb2, 0xc9, 0x6e, 0x42, 0x97, 0xbb, 0x6e, 0xcd, 0xb8, 0x67, 0xab, 0x8d, 0xc4,
+	0x9b, 0xa4, 0x58, 0x3a, 0xca, 0xaa, 0xf1, 0x4a, 0xe5, 0x19, 0xd9, 0xb7, 0xe0, 0x48, 0x22, 0x42,
+	0x61, 0xe8, 0x13, 0x7d, 0xd6, 0xb1, 0xeb, 0x1d, 0x33, 0xe0, 0x86, 0x0c, 0x7e, 0x8a, 0xc2, 0xe8,
+	0x90, 0xd1, 0x6c, 0xa7, 0x5f, 0xcf, 0x31, 0x5a, 0x62, 0x90, 0xf3, 0xeb, 0x33, 0xfa, 0x9b, 0xb0,
+	0x7d, 0x38, 0xa1, 0x7e, 0x38, 0x61, 0xe7, 0x50, 0xc2, 0xee, 0x85, 0x84, 0x27, 0x2f, 0x7f, 0xee,
+	0x4c, 0xed, 0x6c, 0x67, 0x6a, 0xbf, 0x77, 0xa6, 0xf6, 0x65, 0x6f, 0xb6, 0xce, 0xf6, 0x66, 0xeb,
+	0xd7, 0xde, 0x6c, 0x7d, 0x3c, 0xde, 0xf8, 0xb9, 0x57, 0xac, 0x2c, 0x37, 0x0e, 0xe7, 0xea, 0x81,
+	0xd2, 0xef, 0x63, 0x09, 0x7e, 0xbe, 0xad, 0x25, 0x79, 0x79, 0xd9, 0xbc, 0x5c, 0xac, 0x8e, 0xe8,
+	0xbd, 0x3e, 0xfd, 0x33, 0x00, 0x3f, 0x62, 0xb2, 0x1f, 0xcc, 0x03, 0x00, 0x00,
+}
+
+func (m *RevocRegDef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocRegDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocRegDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRevocation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RevocDefType) > 0 {
+		i -= len(m.RevocDefType)
+		copy(dAtA[i:], m.RevocDefType)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.RevocDefType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CredDefId) > 0 {
+		i -= len(m.CredDefId)
+		copy(dAtA[i:], m.CredDefId)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.CredDefId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevocRegDefValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocRegDefValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocRegDefValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		i -= len(m.PublicKeys)
+		copy(dAtA[i:], m.PublicKeys)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.PublicKeys)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IssuanceType) > 0 {
+		i -= len(m.IssuanceType)
+		copy(dAtA[i:], m.IssuanceType)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.IssuanceType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TailsLocation) > 0 {
+		i -= len(m.TailsLocation)
+		copy(dAtA[i:], m.TailsLocation)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.TailsLocation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TailsHash) > 0 {
+		i -= len(m.TailsHash)
+		copy(dAtA[i:], m.TailsHash)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.TailsHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxCredNum != 0 {
+		i = encodeVarintRevocation(dAtA, i, uint64(m.MaxCredNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevocRegEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocRegEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocRegEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRevocation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RevocDefType) > 0 {
+		i -= len(m.RevocDefType)
+		copy(dAtA[i:], m.RevocDefType)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.RevocDefType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RevocRegDefId) > 0 {
+		i -= len(m.RevocRegDefId)
+		copy(dAtA[i:], m.RevocRegDefId)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.RevocRegDefId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevocRegEntryValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocRegEntryValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocRegEntryValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revoked) > 0 {
+		dAtA4 := make([]byte, len(m.Revoked)*10)
+		var j3 int
+		for _, num := range m.Revoked {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintRevocation(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Issued) > 0 {
+		dAtA6 := make([]byte, len(m.Issued)*10)
+		var j5 int
+		for _, num := range m.Issued {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintRevocation(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PrevAccum) > 0 {
+		i -= len(m.PrevAccum)
+		copy(dAtA[i:], m.PrevAccum)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.PrevAccum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accum) > 0 {
+		i -= len(m.Accum)
+		copy(dAtA[i:], m.Accum)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.Accum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevocRegDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocRegDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocRegDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revoked) > 0 {
+		dAtA8 := make([]byte, len(m.Revoked)*10)
+		var j7 int
+		for _, num := range m.Revoked {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintRevocation(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Issued) > 0 {
+		dAtA10 := make([]byte, len(m.Issued)*10)
+		var j9 int
+		for _, num := range m.Issued {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintRevocation(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PrevAccum) > 0 {
+		i -= len(m.PrevAccum)
+		copy(dAtA[i:], m.PrevAccum)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.PrevAccum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Accum) > 0 {
+		i -= len(m.Accum)
+		copy(dAtA[i:], m.Accum)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.Accum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RevocRegDefId) > 0 {
+		i -= len(m.RevocRegDefId)
+		copy(dAtA[i:], m.RevocRegDefId)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.RevocRegDefId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRevocation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRevocation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RevocRegDef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.CredDefId)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.RevocDefType)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	return n
+}
+
+func (m *RevocRegDefValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxCredNum != 0 {
+		n += 1 + sovRevocation(uint64(m.MaxCredNum))
+	}
+	l = len(m.TailsHash)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.TailsLocation)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.IssuanceType)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.PublicKeys)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	return n
+}
+
+func (m *RevocRegEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RevocRegDefId)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.RevocDefType)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	return n
+}
+
+func (m *RevocRegEntryValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Accum)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.PrevAccum)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	if len(m.Issued) > 0 {
+		l = 0
+		for _, e := range m.Issued {
+			l += sovRevocation(uint64(e))
+		}
+		n += 1 + sovRevocation(uint64(l)) + l
+	}
+	if len(m.Revoked) > 0 {
+		l = 0
+		for _, e := range m.Revoked {
+			l += sovRevocation(uint64(e))
+		}
+		n += 1 + sovRevocation(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RevocRegDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RevocRegDefId)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.Accum)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.PrevAccum)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	if len(m.Issued) > 0 {
+		l = 0
+		for _, e := range m.Issued {
+			l += sovRevocation(uint64(e))
+		}
+		n += 1 + sovRevocation(uint64(l)) + l
+	}
+	if len(m.Revoked) > 0 {
+		l = 0
+		for _, e := range m.Revoked {
+			l += sovRevocation(uint64(e))
+		}
+		n += 1 + sovRevocation(uint64(l)) + l
+	}
+	return n
+}
+
+func sovRevocation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRevocation(x uint64) (n int) {
+	return sovRevocation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RevocRegDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocRegDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocRegDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredDefId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredDefId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocDefType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocDefType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &RevocRegDefValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocRegDefValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocRegDefValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocRegDefValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCredNum", wireType)
+			}
+			m.MaxCredNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCredNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TailsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailsLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TailsLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuanceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocRegEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocRegEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocRegEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocRegDefId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocRegDefId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocDefType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocDefType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &RevocRegEntryValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocRegEntryValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocRegEntryValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocRegEntryValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevAccum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevAccum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Issued = append(m.Issued, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRevocation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRevocation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Issued) == 0 {
+					m.Issued = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRevocation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Issued = append(m.Issued, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Revoked = append(m.Revoked, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRevocation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRevocation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Revoked) == 0 {
+					m.Revoked = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRevocation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Revoked = append(m.Revoked, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocRegDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocRegDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocRegDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocRegDefId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocRegDefId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevAccum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevAccum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Issued = append(m.Issued, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRevocation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRevocation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Issued) == 0 {
+					m.Issued = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRevocation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Issued = append(m.Issued, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Revoked = append(m.Revoked, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRevocation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRevocation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Revoked) == 0 {
+					m.Revoked = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRevocation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Revoked = append(m.Revoked, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRevocation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRevocation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRevocation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRevocation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRevocation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRevocation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRevocation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewRevocRegDelta(t *testing.T) {
+	entries := []*RevocRegEntry{
+		{Value: &RevocRegEntryValue{Accum: "1", Issued: []uint64{1, 2, 3}}},
+		{Value: &RevocRegEntryValue{Accum: "2", PrevAccum: "1", Revoked: []uint64{2}}},
+		{Value: &RevocRegEntryValue{Accum: "3", PrevAccum: "2", Revoked: []uint64{1, 3}}},
+		{Value: &RevocRegEntryValue{Accum: "4", PrevAccum: "3", Issued: []uint64{3}}},
+	}
+
+	cases := []struct {
+		name         string
+		issuanceType string
+		entries      []*RevocRegEntry
+		fromCount    int
+		expected     RevocRegDelta
+	}{
+		{
+			"On demand from creation",
+			IssuanceOnDemand,
+			entries,
+			0,
+			RevocRegDelta{RevocRegDefId: "id", Accum: "4", Issued: []uint64{3}, Revoked: nil},
+		},
+		{
+			"On demand from the first entry",
+			IssuanceOnDemand,
+			entries,
+			1,
+			RevocRegDelta{RevocRegDefId: "id", Accum: "4", PrevAccum: "1", Revoked: []uint64{1, 2}},
+		},
+		{
+			"On demand up to the second entry",
+			IssuanceOnDemand,
+			entries[:2],
+			0,
+			RevocRegDelta{RevocRegDefId: "id", Accum: "2", Issued: []uint64{1, 3}},
+		},
+		{
+			"By default from creation",
+			IssuanceByDefault,
+			entries,
+			0,
+			RevocRegDelta{RevocRegDefId: "id", Accum: "4", Revoked: []uint64{1, 2}},
+		},
+		{
+			"By default without changes",
+			IssuanceByDefault,
+			entries,
+			4,
+			RevocRegDelta{RevocRegDefId: "id", Accum: "4", PrevAccum: "4"},
+		},
+		{
+			"No entries",
+			IssuanceByDefault,
+			nil,
+			0,
+			RevocRegDelta{RevocRegDefId: "id"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			def := RevocRegDef{Id: "id", Value: &RevocRegDefValue{IssuanceType: tc.issuanceType}}
+			delta := NewRevocRegDelta(&def, tc.entries, tc.fromCount)
+			require.Equal(t, tc.expected, *delta)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package v1
+
+const (
+	// SchemaType is the only supported schema type
+	SchemaType = "CL-Schema"
+
+	// MaxSchemaAttrNames is the maximum number of attributes in a schema
+	MaxSchemaAttrNames = 125
+)
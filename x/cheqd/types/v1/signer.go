@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils/strings"
+	"reflect"
+)
+
+// GetSignersOnDidUpdate returns the signers required to update the DID Doc.
+// Controllers of the new DID Doc sign the update. Removed controllers and controllers of
+// deleted or changed verification methods have to sign it too.
+func GetSignersOnDidUpdate(oldDIDDoc *Did, newDIDDoc *MsgUpdateDidPayload) []Signer {
+	var signers = newDIDDoc.GetSigners()
+
+	// Get Old DID Doc controller if it's nil then assign self
+	oldController := oldDIDDoc.Controller
+	if len(oldController) == 0 {
+		oldController = []string{oldDIDDoc.Id}
+	}
+
+	// Get New DID Doc controller if it's nil then assign self
+	newController := newDIDDoc.Controller
+	if len(newController) == 0 {
+		newController = []string{newDIDDoc.Id}
+	}
+
+	// DID Doc controller has been changed
+	if removedControllers := strings.Complement(oldController, newController); len(removedControllers) > 0 {
+		for _, controller := range removedControllers {
+			signers = append(signers, Signer{Signer: controller})
+		}
+	}
+
+	for _, oldVM := range oldDIDDoc.VerificationMethod {
+		newVM := FindVerificationMethod(newDIDDoc.VerificationMethod, oldVM.Id)
+
+		// Verification Method has been deleted
+		if newVM == nil {
+			signers = AppendSignerIfNeed(signers, oldVM.Controller, newDIDDoc)
+			continue
+		}
+
+		// Verification Method has been changed
+		if !reflect.DeepEqual(oldVM, newVM) {
+			signers = AppendSignerIfNeed(signers, newVM.Controller, newDIDDoc)
+		}
+
+		// Verification Method Controller has been changed, need to add old controller
+		if newVM.Controller != oldVM.Controller {
+			signers = AppendSignerIfNeed(signers, oldVM.Controller, newDIDDoc)
+		}
+	}
+
+	return signers
+}
+
+func AppendSignerIfNeed(signers []Signer, controller string, msg *MsgUpdateDidPayload) []Signer {
+	for _, signer := range signers {
+		if signer.Signer == controller {
+			return signers
+		}
+	}
+
+	signer := Signer{
+		Signer: controller,
+	}
+
+	if controller == msg.Id {
+		signer.VerificationMethod = msg.VerificationMethod
+		signer.Authentication = msg.Authentication
+	}
+
+	return append(signers, signer)
+}
+
+func FindAuthenticationMethod(signer Signer, id string) (*VerificationMethod, error) {
+	for _, authentication := range signer.Authentication {
+		if authentication == id {
+			vm := FindVerificationMethod(signer.VerificationMethod, id)
+			if vm == nil {
+				return nil, ErrVerificationMethodNotFound.Wrap(id)
+			}
+			return vm, nil
+		}
+	}
+
+	return nil, ErrVerificationMethodNotFound.Wrap(id)
+}
+
+func FindVerificationMethod(vms []*VerificationMethod, id string) *VerificationMethod {
+	for _, vm := range vms {
+		if vm.Id == id {
+			return vm
+		}
+	}
+
+	return nil
+}
+
+func VerifyIdentitySignature(signer Signer, signatures []*SignInfo, signingInput []byte) (bool, error) {
+	result := true
+	foundOne := false
+
+	for _, info := range signatures {
+		did, _ := utils.SplitDidUrlIntoDidAndFragment(info.VerificationMethodId)
+		if did == signer.Signer {
+			vm, err := FindAuthenticationMethod(signer, info.VerificationMethodId)
+			if err != nil {
+				return false, err
+			}
+
+			signature, err := base64.StdEncoding.DecodeString(info.Signature)
+			if err != nil {
+				return false, err
+			}
+
+			valid, err := vm.VerifySignature(signingInput, signature)
+			if err != nil {
+				return false, err
+			}
+
+			result = result && valid
+			foundOne = true
+		}
+	}
+
+	if !foundOne {
+		return false, fmt.Errorf("signature %s not found", signer.Signer)
+	}
+
+	return result, nil
+}
@@ -6,12 +6,30 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
+	"time"
 )
 
 const (
-	StateValueDid = "/cheqdid.cheqdnode.cheqd.v1.Did"
+	StateValueDid           = "/cheqdid.cheqdnode.cheqd.v1.Did"
+	StateValueSchema        = "/cheqdid.cheqdnode.cheqd.v1.Schema"
+	StateValueCredDef       = "/cheqdid.cheqdnode.cheqd.v1.CredDef"
+	StateValueRevocRegDef   = "/cheqdid.cheqdnode.cheqd.v1.RevocRegDef"
+	StateValueRevocRegEntry = "/cheqdid.cheqdnode.cheqd.v1.RevocRegEntry"
+	StateValueStatusList    = "/cheqdid.cheqdnode.cheqd.v1.StatusList"
 )
 
+// StateValueDataName is the name of the StateValueData interface in the interface registry
+const StateValueDataName = "cheqdid.cheqdnode.cheqd.v1.StateValueData"
+
+// StateValueData is the data of a state value. The implementations are registered in the interface registry,
+// so the type URLs of the packed data can be resolved, e.g. when the genesis JSON is decoded.
+type StateValueData interface {
+	proto.Message
+}
+
+// MetadataTimeLayout is the layout of `created` and `updated` metadata fields
+const MetadataTimeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
 func NewStateValue(msg proto.Message, metadata *Metadata) (*StateValue, error) {
 	data, err := types.NewAnyWithValue(msg)
 	if err != nil {
@@ -28,6 +46,24 @@ func NewMetadata(ctx sdk.Context) Metadata {
 	return Metadata{Created: created, Updated: created, Deactivated: false, VersionId: txHash}
 }
 
+func (m Metadata) GetCreatedTime() (time.Time, error) {
+	created, err := time.Parse(MetadataTimeLayout, m.Created)
+	if err != nil {
+		return time.Time{}, ErrInvalidDidStateValue.Wrap(err.Error())
+	}
+
+	return created, nil
+}
+
+func (m Metadata) GetUpdatedTime() (time.Time, error) {
+	updated, err := time.Parse(MetadataTimeLayout, m.Updated)
+	if err != nil {
+		return time.Time{}, ErrInvalidDidStateValue.Wrap(err.Error())
+	}
+
+	return updated, nil
+}
+
 func (m StateValue) GetDid() (*Did, error) {
 	value, isValue := m.Data.GetCachedValue().(Did)
 	if isValue {
@@ -46,3 +82,98 @@ func (m StateValue) GetDid() (*Did, error) {
 
 	return &state, nil
 }
+
+func (m StateValue) GetSchema() (*Schema, error) {
+	value, isValue := m.Data.GetCachedValue().(Schema)
+	if isValue {
+		return &value, nil
+	}
+
+	if m.Data.TypeUrl != StateValueSchema {
+		return nil, ErrInvalidDidStateValue.Wrap(m.Data.TypeUrl)
+	}
+
+	state := Schema{}
+	err := state.Unmarshal(m.Data.Value)
+	if err != nil {
+		return nil, ErrInvalidDidStateValue.Wrap(err.Error())
+	}
+
+	return &state, nil
+}
+
+func (m StateValue) GetCredDef() (*CredDef, error) {
+	value, isValue := m.Data.GetCachedValue().(CredDef)
+	if isValue {
+		return &value, nil
+	}
+
+	if m.Data.TypeUrl != StateValueCredDef {
+		return nil, ErrInvalidDidStateValue.Wrap(m.Data.TypeUrl)
+	}
+
+	state := CredDef{}
+	err := state.Unmarshal(m.Data.Value)
+	if err != nil {
+		return nil, ErrInvalidDidStateValue.Wrap(err.Error())
+	}
+
+	return &state, nil
+}
+
+func (m StateValue) GetRevocRegDef() (*RevocRegDef, error) {
+	value, isValue := m.Data.GetCachedValue().(RevocRegDef)
+	if isValue {
+		return &value, nil
+	}
+
+	if m.Data.TypeUrl != StateValueRevocRegDef {
+		return nil, ErrInvalidDidStateValue.Wrap(m.Data.TypeUrl)
+	}
+
+	state := RevocRegDef{}
+	err := state.Unmarshal(m.Data.Value)
+	if err != nil {
+		return nil, ErrInvalidDidStateValue.Wrap(err.Error())
+	}
+
+	return &state, nil
+}
+
+func (m StateValue) GetRevocRegEntry() (*RevocRegEntry, error) {
+	value, isValue := m.Data.GetCachedValue().(RevocRegEntry)
+	if isValue {
+		return &value, nil
+	}
+
+	if m.Data.TypeUrl != StateValueRevocRegEntry {
+		return nil, ErrInvalidDidStateValue.Wrap(m.Data.TypeUrl)
+	}
+
+	state := RevocRegEntry{}
+	err := state.Unmarshal(m.Data.Value)
+	if err != nil {
+		return nil, ErrInvalidDidStateValue.Wrap(err.Error())
+	}
+
+	return &state, nil
+}
+
+func (m StateValue) GetStatusList() (*StatusList, error) {
+	value, isValue := m.Data.GetCachedValue().(StatusList)
+	if isValue {
+		return &value, nil
+	}
+
+	if m.Data.TypeUrl != StateValueStatusList {
+		return nil, ErrInvalidDidStateValue.Wrap(m.Data.TypeUrl)
+	}
+
+	state := StatusList{}
+	err := state.Unmarshal(m.Data.Value)
+	if err != nil {
+		return nil, ErrInvalidDidStateValue.Wrap(err.Error())
+	}
+
+	return &state, nil
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+)
+
+const (
+	// StatusPurposeRevocation status bits can't be unset once set
+	StatusPurposeRevocation = "revocation"
+
+	// StatusPurposeSuspension status bits can be set and unset
+	StatusPurposeSuspension = "suspension"
+
+	// MinStatusListLength is the minimal bitstring length (16KB) required by StatusList2021 for herd privacy
+	MinStatusListLength = 131072
+
+	// MaxStatusListLength is the maximal bitstring length (128KB)
+	MaxStatusListLength = 8 * MinStatusListLength
+
+	// StatusListPageSize is the size of the bitstring pages kept in the store.
+	// Updates rewrite only the pages with changed bits instead of the whole bitstring.
+	StatusListPageSize = 1024
+
+	// StatusListPageLength is the number of bits in a bitstring page
+	StatusListPageLength = 8 * StatusListPageSize
+
+	// StatusListEncodingPrefix is the multibase prefix of base64url encoded lists
+	StatusListEncodingPrefix = "u"
+)
+
+// NewStatusList returns a status list with all bits unset
+func NewStatusList(id string, statusPurpose string, length uint64, controller []string) StatusList {
+	return StatusList{
+		Id:            id,
+		StatusPurpose: statusPurpose,
+		Length:        length,
+		Controller:    controller,
+		Bitstring:     make([]byte, (length+7)/8),
+	}
+}
+
+// GetBit returns whether the bit at the index is set
+func (list *StatusList) GetBit(index uint64) bool {
+	return GetStatusListBit(list.Bitstring, index)
+}
+
+// SetBit sets or unsets the bit at the index
+func (list *StatusList) SetBit(index uint64, value bool) {
+	SetStatusListBit(list.Bitstring, index, value)
+}
+
+// GetStatusListBit returns whether the bit at the index of the bitstring is set
+func GetStatusListBit(bitstring []byte, index uint64) bool {
+	return bitstring[index/8]&(0x80>>(index%8)) != 0
+}
+
+// SetStatusListBit sets or unsets the bit at the index of the bitstring
+func SetStatusListBit(bitstring []byte, index uint64, value bool) {
+	if value {
+		bitstring[index/8] |= 0x80 >> (index % 8)
+	} else {
+		bitstring[index/8] &^= 0x80 >> (index % 8)
+	}
+}
+
+// Encode returns the GZIP compressed and multibase base64url encoded bitstring required by StatusList2021
+func (list *StatusList) Encode() (string, error) {
+	var buf bytes.Buffer
+
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(list.Bitstring); err != nil {
+		return "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return StatusListEncodingPrefix + base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
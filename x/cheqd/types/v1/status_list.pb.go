@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/status_list.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StatusList is a StatusList2021 bitstring, the bit at index 0 is the most significant bit of the first byte
+type StatusList struct {
+	Id            string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StatusPurpose string   `protobuf:"bytes,2,opt,name=status_purpose,json=statusPurpose,proto3" json:"status_purpose,omitempty"`
+	Length        uint64   `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Controller    []string `protobuf:"bytes,4,rep,name=controller,proto3" json:"controller,omitempty"`
+	Bitstring     []byte   `protobuf:"bytes,5,opt,name=bitstring,proto3" json:"bitstring,omitempty"`
+}
+
+func (m *StatusList) Reset()         { *m = StatusList{} }
+func (m *StatusList) String() string { return proto.CompactTextString(m) }
+func (*StatusList) ProtoMessage()    {}
+func (*StatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e712e7a34883e27, []int{0}
+}
+func (m *StatusList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusList.Merge(m, src)
+}
+func (m *StatusList) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusList proto.InternalMessageInfo
+
+func (m *StatusList) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StatusList) GetStatusPurpose() string {
+	if m != nil {
+		return m.StatusPurpose
+	}
+	return ""
+}
+
+func (m *StatusList) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *StatusList) GetController() []string {
+	if m != nil {
+		return m.Controller
+	}
+	return nil
+}
+
+func (m *StatusList) GetBitstring() []byte {
+	if m != nil {
+		return m.Bitstring
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StatusList)(nil), "cheqdid.cheqdnode.cheqd.v1.StatusList")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/status_list.proto", fileDescriptor_9e712e7a34883e27) }
+
+var fileDescriptor_9e712e7a34883e27 = []byte{
+	// 245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x48, 0x2d,
+	0x4c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2e, 0x49, 0x2c, 0x29, 0x2d, 0x8e, 0xcf, 0xc9, 0x2c, 0x2e,
+	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x82, 0xc8, 0x65, 0xa6, 0xe8, 0x81, 0xe9, 0xbc, 0xfc,
+	0x94, 0x54, 0x08, 0x4b, 0xaf, 0xcc, 0x50, 0x69, 0x26, 0x23, 0x17, 0x57, 0x30, 0x58, 0x87, 0x4f,
+	0x66, 0x71, 0x89, 0x10, 0x1f, 0x17, 0x53, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10,
+	0x53, 0x66, 0x8a, 0x90, 0x2a, 0x17, 0x1f, 0xd4, 0xbc, 0x82, 0xd2, 0xa2, 0x82, 0xfc, 0xe2, 0x54,
+	0x09, 0x26, 0xb0, 0x1c, 0x2f, 0x44, 0x34, 0x00, 0x22, 0x28, 0x24, 0xc6, 0xc5, 0x96, 0x93, 0x9a,
+	0x97, 0x5e, 0x92, 0x21, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x12, 0x04, 0xe5, 0x09, 0xc9, 0x71, 0x71,
+	0x25, 0xe7, 0xe7, 0x95, 0x14, 0xe5, 0xe7, 0xe4, 0xa4, 0x16, 0x49, 0xb0, 0x28, 0x30, 0x6b, 0x70,
+	0x06, 0x21, 0x89, 0x08, 0xc9, 0x70, 0x71, 0x26, 0x65, 0x96, 0x14, 0x97, 0x14, 0x65, 0xe6, 0xa5,
+	0x4b, 0xb0, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0x21, 0x04, 0x9c, 0xdc, 0x4e, 0x3c, 0x92, 0x63, 0xbc,
+	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
+	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x27, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
+	0x57, 0x1f, 0xe2, 0x71, 0x30, 0xa9, 0x0b, 0xf2, 0x9b, 0x7e, 0x05, 0x54, 0xa8, 0xa4, 0xb2, 0x20,
+	0xb5, 0x58, 0xbf, 0xcc, 0x30, 0x89, 0x0d, 0x1c, 0x0c, 0xc6, 0x80, 0x01, 0x00, 0xe2, 0xd5, 0x98,
+	0x38, 0x24, 0x01, 0x00, 0x00,
+}
+
+func (m *StatusList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bitstring) > 0 {
+		i -= len(m.Bitstring)
+		copy(dAtA[i:], m.Bitstring)
+		i = encodeVarintStatusList(dAtA, i, uint64(len(m.Bitstring)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Controller) > 0 {
+		for iNdEx := len(m.Controller) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Controller[iNdEx])
+			copy(dAtA[i:], m.Controller[iNdEx])
+			i = encodeVarintStatusList(dAtA, i, uint64(len(m.Controller[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Length != 0 {
+		i = encodeVarintStatusList(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StatusPurpose) > 0 {
+		i -= len(m.StatusPurpose)
+		copy(dAtA[i:], m.StatusPurpose)
+		i = encodeVarintStatusList(dAtA, i, uint64(len(m.StatusPurpose)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintStatusList(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStatusList(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStatusList(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StatusList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovStatusList(uint64(l))
+	}
+	l = len(m.StatusPurpose)
+	if l > 0 {
+		n += 1 + l + sovStatusList(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovStatusList(uint64(m.Length))
+	}
+	if len(m.Controller) > 0 {
+		for _, s := range m.Controller {
+			l = len(s)
+			n += 1 + l + sovStatusList(uint64(l))
+		}
+	}
+	l = len(m.Bitstring)
+	if l > 0 {
+		n += 1 + l + sovStatusList(uint64(l))
+	}
+	return n
+}
+
+func sovStatusList(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStatusList(x uint64) (n int) {
+	return sovStatusList(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StatusList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatusList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusPurpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusPurpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = append(m.Controller, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitstring", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitstring = append(m.Bitstring[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitstring == nil {
+				m.Bitstring = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatusList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStatusList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStatusList(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStatusList
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStatusList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStatusList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStatusList
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStatusList
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStatusList
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStatusList        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStatusList          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStatusList = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestStatusListBits(t *testing.T) {
+	list := NewStatusList("did:cheqd:test:list", StatusPurposeSuspension, 16, nil)
+	require.Len(t, list.Bitstring, 2)
+
+	list.SetBit(0, true)
+	list.SetBit(9, true)
+	require.Equal(t, []byte{0x80, 0x40}, list.Bitstring)
+	require.True(t, list.GetBit(0))
+	require.False(t, list.GetBit(1))
+	require.True(t, list.GetBit(9))
+
+	list.SetBit(0, false)
+	require.Equal(t, []byte{0x00, 0x40}, list.Bitstring)
+}
+
+func TestStatusListEncode(t *testing.T) {
+	list := NewStatusList("did:cheqd:test:list", StatusPurposeRevocation, MinStatusListLength, nil)
+	list.SetBit(42, true)
+
+	encoded, err := list.Encode()
+	require.Nil(t, err)
+
+	require.True(t, strings.HasPrefix(encoded, StatusListEncodingPrefix))
+
+	compressed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(encoded, StatusListEncodingPrefix))
+	require.Nil(t, err)
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.Nil(t, err)
+
+	decoded, err := ioutil.ReadAll(reader)
+	require.Nil(t, err)
+	require.Equal(t, list.Bitstring, decoded)
+}
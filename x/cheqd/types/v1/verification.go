@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// SignatureVerifier checks a signature made with the key of a verification method
+type SignatureVerifier func(vm VerificationMethod, message []byte, signature []byte) (bool, error)
+
+var signatureVerifiers = map[string]SignatureVerifier{
+	utils.JsonWebKey2020:                    VerifyJsonWebKey2020Signature,
+	utils.Ed25519VerificationKey2020:        VerifyEd25519Signature,
+	utils.EcdsaSecp256k1VerificationKey2019: VerifySecp256k1Signature,
+	utils.EcdsaSecp256k1RecoveryMethod2020:  VerifySecp256k1RecoverableSignature,
+}
+
+// RegisterSignatureVerifier sets the verifier used for verification methods of the given type
+func RegisterSignatureVerifier(vmType string, verifier SignatureVerifier) {
+	signatureVerifiers[vmType] = verifier
+}
+
+func (v VerificationMethod) VerifySignature(message []byte, signature []byte) (bool, error) {
+	verifier, found := signatureVerifiers[v.Type]
+	if !found {
+		return false, ErrInvalidPublicKey.Wrapf("signatures of %s verification methods are not supported", v.Type)
+	}
+
+	return verifier(v, message, signature)
+}
+
+func VerifyEd25519Signature(vm VerificationMethod, message []byte, signature []byte) (bool, error) {
+	pubKey, err := vm.GetPublicKey()
+	if err != nil {
+		return false, err
+	}
+
+	if len(pubKey) != ed25519.PublicKeySize {
+		return false, ErrInvalidPublicKey.Wrapf("verification method '%s' ed25519 public key must be %d bytes long", vm.Id, ed25519.PublicKeySize)
+	}
+
+	return ed25519.Verify(pubKey, message, signature), nil
+}
+
+// VerifyJsonWebKey2020Signature verifies a signature with the algorithm matching the JWK:
+// EdDSA for Ed25519, ES256 / ES256K (R || S over SHA-256) for EC keys, RS256 or PS256 (by `alg` member) for RSA keys.
+func VerifyJsonWebKey2020Signature(vm VerificationMethod, message []byte, signature []byte) (bool, error) {
+	key, err := vm.GetJwkPublicKey()
+	if err != nil {
+		return false, err
+	}
+
+	hash := sha256.Sum256(message)
+
+	switch pubKey := key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(pubKey, message, signature), nil
+	case *btcec.PublicKey:
+		return secp256k1.PubKey(pubKey.SerializeCompressed()).VerifySignature(message, signature), nil
+	case *ecdsa.PublicKey:
+		if len(signature) != 64 {
+			return false, nil
+		}
+
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(pubKey, hash[:], r, s), nil
+	case *rsa.PublicKey:
+		if vm.GetJwk()["alg"] == "PS256" {
+			return rsa.VerifyPSS(pubKey, crypto.SHA256, hash[:], signature, nil) == nil, nil
+		}
+
+		return rsa.VerifyPKCS1v15(pubKey, crypto.SHA256, hash[:], signature) == nil, nil
+	default:
+		return false, ErrInvalidPublicKey.Wrapf("verification method '%s' public key type is not supported", vm.Id)
+	}
+}
+
+// VerifySecp256k1Signature verifies a signature of the form R || S over the SHA-256 hash of the message,
+// the same way Cosmos account signatures are verified.
+func VerifySecp256k1Signature(vm VerificationMethod, message []byte, signature []byte) (bool, error) {
+	pubKey, err := getSecp256k1PublicKey(vm)
+	if err != nil {
+		return false, err
+	}
+
+	return secp256k1.PubKey(pubKey.SerializeCompressed()).VerifySignature(message, signature), nil
+}
+
+// VerifySecp256k1RecoverableSignature verifies a signature of the form R || S || V over the SHA-256 hash of the message
+// by recovering the public key from it. Signatures of the form R || S are verified directly.
+func VerifySecp256k1RecoverableSignature(vm VerificationMethod, message []byte, signature []byte) (bool, error) {
+	if len(signature) != 65 {
+		return VerifySecp256k1Signature(vm, message, signature)
+	}
+
+	pubKey, err := getSecp256k1PublicKey(vm)
+	if err != nil {
+		return false, err
+	}
+
+	// Convert R || S || V to the compact form V || R || S expected by btcec
+	recoveryId := signature[64]
+	if recoveryId >= 27 {
+		recoveryId -= 27
+	}
+
+	if recoveryId > 3 {
+		return false, nil
+	}
+
+	compact := append([]byte{27 + recoveryId}, signature[:64]...)
+	hash := sha256.Sum256(message)
+
+	recovered, _, err := btcec.RecoverCompact(btcec.S256(), compact, hash[:])
+	if err != nil {
+		return false, nil
+	}
+
+	return recovered.IsEqual(pubKey), nil
+}
+
+func getSecp256k1PublicKey(vm VerificationMethod) (*btcec.PublicKey, error) {
+	if len(vm.PublicKeyJwk) > 0 {
+		key, err := vm.GetJwkPublicKey()
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey, ok := key.(*btcec.PublicKey)
+		if !ok {
+			return nil, ErrInvalidPublicKey.Wrapf("verification method '%s' public key jwk must be a secp256k1 key", vm.Id)
+		}
+
+		return pubKey, nil
+	}
+
+	keyBytes, err := vm.GetPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(keyBytes, btcec.S256())
+	if err != nil {
+		return nil, ErrInvalidPublicKey.Wrapf("verification method '%s' secp256k1 public key: %s", vm.Id, err.Error())
+	}
+
+	return pubKey, nil
+}
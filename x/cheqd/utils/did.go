@@ -7,8 +7,17 @@ import (
 
 var DidForbiddenSymbolsRegexp, _ = regexp.Compile(`^[^#?&/\\]+$`)
 
+// DidSyntaxRegexp matches the generic DID syntax `did:<method-name>:<method-specific-id>`
+var DidSyntaxRegexp, _ = regexp.Compile(`^did:([a-z0-9]+):((?:[a-zA-Z0-9._%-]*:)*[a-zA-Z0-9._%-]+)$`)
+
+// SplitDidUrlIntoDidAndFragment splits a DID URL at the first '#'.
+// The fragment is empty if the DID URL doesn't contain it.
 func SplitDidUrlIntoDidAndFragment(didUrl string) (string, string) {
-	fragments := strings.Split(didUrl, "#")
+	fragments := strings.SplitN(didUrl, "#", 2)
+	if len(fragments) == 1 {
+		return fragments[0], ""
+	}
+
 	return fragments[0], fragments[1]
 }
 
@@ -76,3 +85,14 @@ func IsValidDid(prefix string, did string) bool {
 
 	return strings.HasPrefix(did, prefix)
 }
+
+// SplitDid splits a DID into the method name and the method specific id.
+// The last value is false if the DID doesn't match the generic DID syntax.
+func SplitDid(did string) (string, string, bool) {
+	matches := DidSyntaxRegexp.FindStringSubmatch(did)
+	if matches == nil {
+		return "", "", false
+	}
+
+	return matches[1], matches[2], true
+}
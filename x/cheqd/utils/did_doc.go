@@ -1,26 +1,18 @@
 package utils
 
-import "github.com/cheqd/cheqd-node/x/cheqd/utils/strings"
-
 const (
 	PublicKeyJwk       = "PublicKeyJwk"
 	PublicKeyMultibase = "PublicKeyMultibase"
 )
 
-var VerificationMethodType = map[string]string{
-	"JsonWebKey2020":             PublicKeyJwk,
-	"Ed25519VerificationKey2020": PublicKeyMultibase,
-}
-
-var ServiceType = []string{
-	"LinkedDomains",
-	"DIDCommMessaging",
-}
-
-func GetVerificationMethodType(vmType string) string {
-	return VerificationMethodType[vmType]
-}
+const (
+	JsonWebKey2020                    = "JsonWebKey2020"
+	Ed25519VerificationKey2020        = "Ed25519VerificationKey2020"
+	EcdsaSecp256k1VerificationKey2019 = "EcdsaSecp256k1VerificationKey2019"
+	EcdsaSecp256k1RecoveryMethod2020  = "EcdsaSecp256k1RecoveryMethod2020"
+)
 
-func IsValidDidServiceType(sType string) bool {
-	return strings.Contains(ServiceType, sType)
-}
+const (
+	LinkedDomains    = "LinkedDomains"
+	DIDCommMessaging = "DIDCommMessaging"
+)
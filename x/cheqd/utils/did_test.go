@@ -34,3 +34,28 @@ func TestIsDid(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitDid(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		did    string
+		method string
+		id     string
+	}{
+		{true, "did:cheqd:test:wyywywywyw", "cheqd", "test:wyywywywyw"},
+		{true, "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", "key", "z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"},
+		{false, "did:Cheqd:test:wyywywywyw", "", ""},
+		{false, "did:cheqd:test:", "", ""},
+		{false, "did:cheqd", "", ""},
+		{false, "did:cheqd:test:wyywywywyw#key1", "", ""},
+		{false, "cheqd:test:wyywywywyw", "", ""},
+	}
+
+	for _, tc := range cases {
+		method, id, ok := SplitDid(tc.did)
+
+		require.Equal(t, tc.valid, ok)
+		require.Equal(t, tc.method, method)
+		require.Equal(t, tc.id, id)
+	}
+}
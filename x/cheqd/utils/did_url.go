@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// DidUrl is a parsed DID URL: `did path-abempty [ "?" query ] [ "#" fragment ]`
+type DidUrl struct {
+	Did      string
+	Path     string
+	Query    url.Values
+	Fragment string
+}
+
+// ParseDidUrl parses a DID URL into its DID, path, query and fragment
+func ParseDidUrl(didUrl string) (*DidUrl, error) {
+	rest, fragment := SplitDidUrlIntoDidAndFragment(didUrl)
+	rest, rawQuery := splitOnce(rest, "?")
+	did, path := splitOnce(rest, "/")
+
+	if _, _, ok := SplitDid(did); !ok {
+		return nil, errors.New("DID URL must start with a valid DID")
+	}
+
+	if len(path) > 0 {
+		path = "/" + path
+	}
+
+	query, err := parseQuery(rawQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DidUrl{
+		Did:      did,
+		Path:     path,
+		Query:    query,
+		Fragment: fragment,
+	}, nil
+}
+
+// parseQuery parses the DID URL query. Unlike `url.ParseQuery` it follows RFC 3986 and doesn't decode '+' as a space,
+// so base64 encoded values like version ids can be passed as is.
+func parseQuery(rawQuery string) (url.Values, error) {
+	query := url.Values{}
+
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if len(pair) == 0 {
+			continue
+		}
+
+		key, value := splitOnce(pair, "=")
+
+		key, err := url.PathUnescape(key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err = url.PathUnescape(value)
+		if err != nil {
+			return nil, err
+		}
+
+		query.Add(key, value)
+	}
+
+	return query, nil
+}
+
+func splitOnce(value string, separator string) (string, string) {
+	parts := strings.SplitN(value, separator, 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDidUrl(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		didUrl string
+		result DidUrl
+	}{
+		{true, "did:cheqd:test:alice", DidUrl{Did: "did:cheqd:test:alice", Query: url.Values{}}},
+		{true, "did:cheqd:test:alice#key-1", DidUrl{Did: "did:cheqd:test:alice", Query: url.Values{}, Fragment: "key-1"}},
+		{
+			true,
+			"did:cheqd:test:alice?service=agent&relativeRef=/inbox",
+			DidUrl{Did: "did:cheqd:test:alice", Query: url.Values{"service": {"agent"}, "relativeRef": {"/inbox"}}},
+		},
+		{
+			true,
+			"did:cheqd:test:alice/resources/a09abea0-22e0-4b35-8f70-9cc3a6d0b5fd?versionTime=2021-01-01T00:00:00Z#part",
+			DidUrl{
+				Did:      "did:cheqd:test:alice",
+				Path:     "/resources/a09abea0-22e0-4b35-8f70-9cc3a6d0b5fd",
+				Query:    url.Values{"versionTime": {"2021-01-01T00:00:00Z"}},
+				Fragment: "part",
+			},
+		},
+		{
+			true,
+			"did:cheqd:test:alice?versionId=Yr+7/c2A=&service=a%20b",
+			DidUrl{Did: "did:cheqd:test:alice", Query: url.Values{"versionId": {"Yr+7/c2A="}, "service": {"a b"}}},
+		},
+		{false, "#key-1", DidUrl{}},
+		{false, "did:cheqd:test:alice?service=%zz", DidUrl{}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.didUrl, func(t *testing.T) {
+			result, err := ParseDidUrl(tc.didUrl)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, tc.result, *result)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitDidUrlIntoDidAndFragment(t *testing.T) {
+	did, fragment := SplitDidUrlIntoDidAndFragment("did:cheqd:test:alice#key-1")
+	require.Equal(t, "did:cheqd:test:alice", did)
+	require.Equal(t, "key-1", fragment)
+
+	did, fragment = SplitDidUrlIntoDidAndFragment("did:cheqd:test:alice")
+	require.Equal(t, "did:cheqd:test:alice", did)
+	require.Empty(t, fragment)
+}
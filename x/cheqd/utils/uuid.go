@@ -0,0 +1,9 @@
+package utils
+
+import "regexp"
+
+var UUIDRegexp, _ = regexp.Compile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func IsValidUUID(id string) bool {
+	return UUIDRegexp.MatchString(id)
+}
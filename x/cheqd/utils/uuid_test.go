@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestIsValidUUID(t *testing.T) {
+	cases := []struct {
+		valid bool
+		id    string
+	}{
+		{true, "42a7b8e5-7d85-4c2a-9b1f-8e3a7cbd4c3e"},
+		{true, "42A7B8E5-7D85-4C2A-9B1F-8E3A7CBD4C3E"},
+		{false, ""},
+		{false, "42a7b8e57d854c2a9b1f8e3a7cbd4c3e"},
+		{false, "42a7b8e5-7d85-4c2a-9b1f-8e3a7cbd4c3"},
+		{false, "42a7b8e5-7d85-4c2a-9b1f-8e3a7cbd4c3g"},
+		{false, "did:cheqd:test:42a7b8e5-7d85-4c2a-9b1f-8e3a7cbd4c3e"},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.valid, IsValidUUID(tc.id), tc.id)
+	}
+}